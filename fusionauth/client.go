@@ -2,12 +2,15 @@ package fusionauth
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
 	"net/url"
 	"time"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -16,11 +19,15 @@ type Client struct {
 	FAClient fusionauth.FusionAuthClient
 	Host     string
 	APIKey   string
+	// ServerVersion is the version reported by the FusionAuth server when the
+	// provider was configured. It is empty if the version couldn't be read.
+	ServerVersion string
 }
 
-func configureClient(_ context.Context, data *schema.ResourceData) (client interface{}, diags diag.Diagnostics) {
+func configureClient(ctx context.Context, data *schema.ResourceData) (client interface{}, diags diag.Diagnostics) {
 	host := data.Get("host").(string)
 	apiKey := data.Get("api_key").(string)
+	tenantID := data.Get("tenant_id").(string)
 
 	hostURL, err := url.Parse(host)
 	if err != nil {
@@ -32,18 +39,187 @@ func configureClient(_ context.Context, data *schema.ResourceData) (client inter
 		return nil, diags
 	}
 
+	httpClient, err := newHTTPClient(data)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Fusionauth client",
+			Detail:   fmt.Sprintf("Unable to configure the HTTP transport: %s", err),
+		})
+		return nil, diags
+	}
+
+	faClient := fusionauth.NewClientWithRetryConfiguration(
+		httpClient,
+		hostURL,
+		apiKey,
+		newRetryConfiguration(data),
+	)
+	// The provider level tenant is the default for every request, resources
+	// can override it with their own tenant_id.
+	faClient.TenantId = tenantID
+
+	if data.Get("wait_for_ready").(bool) {
+		timeout := time.Duration(data.Get("ready_timeout").(int)) * time.Second
+		if err := waitForReady(ctx, *faClient, timeout); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "FusionAuth did not become ready",
+				Detail:   fmt.Sprintf("FusionAuth at %s did not report a healthy status within %s: %s", host, timeout, err),
+			})
+			return nil, diags
+		}
+	}
+
 	client = Client{
-		Host:   host,
-		APIKey: apiKey,
-		FAClient: *fusionauth.NewClientWithRetryConfiguration(
-			&http.Client{
-				Timeout: time.Second * 30,
-			},
-			hostURL,
-			apiKey,
-			fusionauth.RetryConfigurationFromEnv(),
-		),
+		Host:          host,
+		APIKey:        apiKey,
+		FAClient:      *faClient,
+		ServerVersion: retrieveServerVersion(ctx, *faClient),
 	}
 
 	return
 }
+
+// readyInitialBackoff and readyMaxBackoff bound the delay between status
+// checks while waiting for FusionAuth to become ready.
+var (
+	readyInitialBackoff = time.Second
+	readyMaxBackoff     = 15 * time.Second
+)
+
+// waitForReady polls the FusionAuth status endpoint, backing off between
+// attempts, until the server reports that it is healthy or the timeout
+// elapses. This covers FusionAuth still starting up or running kickstart when
+// Terraform is applied in the same pipeline.
+func waitForReady(ctx context.Context, faClient fusionauth.FusionAuthClient, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	lastErr := context.DeadlineExceeded
+	backoff := readyInitialBackoff
+	for {
+		resp, err := faClient.RetrieveSystemStatusWithContext(ctx)
+		switch {
+		case err == nil && resp.StatusCode == http.StatusOK:
+			return nil
+		case err == nil:
+			lastErr = fmt.Errorf("status endpoint returned %d(%s)", resp.StatusCode, http.StatusText(resp.StatusCode))
+		case ctx.Err() == nil:
+			lastErr = err
+		}
+		tflog.Debug(ctx, "Waiting for FusionAuth to become ready", map[string]interface{}{"error": lastErr.Error()})
+
+		select {
+		case <-ctx.Done():
+			return lastErr
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > readyMaxBackoff {
+			backoff = readyMaxBackoff
+		}
+	}
+}
+
+// retrieveServerVersion returns the version of the FusionAuth server. A failure
+// is not fatal, it only disables the plan time version checks.
+func retrieveServerVersion(ctx context.Context, faClient fusionauth.FusionAuthClient) string {
+	resp, faErrs, err := faClient.RetrieveVersionWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, faErrs)
+	}
+	if err != nil {
+		tflog.Warn(ctx, "Unable to retrieve the FusionAuth server version", map[string]interface{}{"error": err.Error()})
+		return ""
+	}
+
+	return resp.Version
+}
+
+// newHTTPClient builds the HTTP client shared by the FusionAuth client and any
+// hand-rolled API requests from the provider's transport settings.
+func newHTTPClient(data *schema.ResourceData) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if proxy := data.Get("http_proxy").(string); proxy != "" {
+		proxyURL, err := url.Parse(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse http_proxy: %s", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		//nolint:gosec // Explicitly opted into by the practitioner for development.
+		InsecureSkipVerify: data.Get("insecure_skip_verify").(bool),
+	}
+
+	if caCerts := handleStringSliceFromList(data.Get("ca_certificates").([]interface{})); len(caCerts) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		for i, pem := range caCerts {
+			if !pool.AppendCertsFromPEM([]byte(pem)) {
+				return nil, fmt.Errorf("ca_certificates.%d: no PEM encoded certificates found", i)
+			}
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	clientCert := data.Get("client_certificate").(string)
+	clientKey := data.Get("client_key").(string)
+	if clientCert != "" || clientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(clientCert), []byte(clientKey))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client_certificate and client_key: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{
+		Transport: &requestTimeoutTransport{
+			transport: &loggingTransport{transport: transport, traceBodies: traceLoggingEnabled()},
+			timeout:   time.Duration(data.Get("request_timeout").(int)) * time.Second,
+		},
+	}, nil
+}
+
+// newRetryConfiguration returns the retry configuration for the FusionAuth
+// client. When max_retries isn't configured, which its default of -1 stands
+// for, the FUSIONAUTH_ENABLE_RETRY environment variable is honoured instead.
+// The configured delays apply either way.
+func newRetryConfiguration(data *schema.ResourceData) *fusionauth.RetryConfiguration {
+	var retryConfig *fusionauth.RetryConfiguration
+	if maxRetries := data.Get("max_retries").(int); maxRetries < 0 {
+		retryConfig = fusionauth.RetryConfigurationFromEnv()
+		if retryConfig == nil {
+			return nil
+		}
+	} else {
+		retryConfig = fusionauth.NewBasicRetryConfiguration()
+		retryConfig.MaxRetries = maxRetries
+	}
+
+	retryConfig.InitialDelay = time.Duration(data.Get("retry_wait_min_ms").(int)) * time.Millisecond
+	retryConfig.MaxDelay = time.Duration(data.Get("retry_wait_max_ms").(int)) * time.Millisecond
+
+	return retryConfig
+}
+
+// withTenantID returns a copy of the client that sends the provided tenant id
+// with every request. The client shared by the provider is never mutated, so
+// resources applied in parallel can't leak their tenant into each other's API
+// calls. An empty tenant id leaves the client's current tenant in place.
+func (c Client) withTenantID(tenantID string) Client {
+	if tenantID != "" {
+		c.FAClient.TenantId = tenantID
+	}
+
+	return c
+}
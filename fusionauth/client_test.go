@@ -0,0 +1,298 @@
+package fusionauth
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// newTenantEchoServer returns a fake FusionAuth server that answers entity and
+// user requests with an object owned by whichever tenant the request was
+// scoped to via the X-FusionAuth-TenantId header.
+func newTenantEchoServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenantID := r.Header.Get("X-FusionAuth-TenantId")
+		id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+
+		var body interface{}
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/api/entity/"):
+			body = fusionauth.EntityResponse{Entity: fusionauth.Entity{Id: id, Name: "entity", TenantId: tenantID}}
+		case strings.HasPrefix(r.URL.Path, "/api/user/"):
+			body = fusionauth.UserResponse{User: fusionauth.User{TenantId: tenantID, SecureIdentity: fusionauth.SecureIdentity{Id: id}}}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func Test_withTenantID_doesNotMutateSharedClient(t *testing.T) {
+	client := Client{FAClient: fusionauth.FusionAuthClient{TenantId: "provider"}}
+
+	scoped := client.withTenantID("resource")
+	if scoped.FAClient.TenantId != "resource" {
+		t.Errorf("scoped TenantId = %q, want %q", scoped.FAClient.TenantId, "resource")
+	}
+	if client.FAClient.TenantId != "provider" {
+		t.Errorf("shared TenantId = %q, want %q", client.FAClient.TenantId, "provider")
+	}
+	if got := client.withTenantID("").FAClient.TenantId; got != "provider" {
+		t.Errorf("empty override TenantId = %q, want %q", got, "provider")
+	}
+}
+
+func Test_tenantScopedOperations_parallel(t *testing.T) {
+	srv := newTenantEchoServer(t)
+	hostURL, _ := url.Parse(srv.URL)
+
+	var meta interface{} = Client{
+		Host:     srv.URL,
+		FAClient: *fusionauth.NewClient(srv.Client(), hostURL, "key"),
+	}
+
+	const workers = 50
+
+	var wg sync.WaitGroup
+	errs := make(chan string, workers*2)
+	for n := 0; n < workers; n++ {
+		tenantID, _ := uuid.GenerateUUID()
+		objectID, _ := uuid.GenerateUUID()
+
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+
+			data := schema.TestResourceDataRaw(t, resourceEntity().Schema, map[string]interface{}{
+				"tenant_id": tenantID,
+			})
+			data.SetId(objectID)
+			if diags := readEntity(context.Background(), data, meta); diags.HasError() {
+				errs <- diags[0].Summary
+				return
+			}
+			if got := data.Get("tenant_id").(string); got != tenantID {
+				errs <- "entity read with tenant " + got + ", want " + tenantID
+			}
+		}()
+		go func() {
+			defer wg.Done()
+
+			data := schema.TestResourceDataRaw(t, newUser().Schema, map[string]interface{}{
+				"tenant_id": tenantID,
+				"user_id":   objectID,
+				"email":     "user@example.com",
+			})
+			if diags := createUser(context.Background(), data, meta); diags.HasError() {
+				errs <- diags[0].Summary
+				return
+			}
+			if got := data.Get("tenant_id").(string); got != tenantID {
+				errs <- "user created in tenant " + got + ", want " + tenantID
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+
+	if got := meta.(Client).FAClient.TenantId; got != "" {
+		t.Errorf("shared client TenantId = %q, want it untouched", got)
+	}
+}
+
+func Test_providerTenantID_inheritedByResources(t *testing.T) {
+	srv := newTenantEchoServer(t)
+	providerTenantID, _ := uuid.GenerateUUID()
+
+	p := Provider()
+	raw := map[string]interface{}{
+		"host":      srv.URL,
+		"api_key":   "key",
+		"tenant_id": providerTenantID,
+	}
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		t.Fatalf("configuring provider: %v", diags)
+	}
+
+	userID, _ := uuid.GenerateUUID()
+	data := schema.TestResourceDataRaw(t, newUser().Schema, map[string]interface{}{
+		"user_id": userID,
+		"email":   "user@example.com",
+	})
+	if diags := createUser(context.Background(), data, p.Meta()); diags.HasError() {
+		t.Fatalf("createUser: %v", diags)
+	}
+	if got := data.Get("tenant_id").(string); got != providerTenantID {
+		t.Errorf("user tenant_id = %q, want provider tenant %q", got, providerTenantID)
+	}
+
+	resourceTenantID, _ := uuid.GenerateUUID()
+	data = schema.TestResourceDataRaw(t, newUser().Schema, map[string]interface{}{
+		"tenant_id": resourceTenantID,
+		"user_id":   userID,
+		"email":     "user@example.com",
+	})
+	if diags := createUser(context.Background(), data, p.Meta()); diags.HasError() {
+		t.Fatalf("createUser: %v", diags)
+	}
+	if got := data.Get("tenant_id").(string); got != resourceTenantID {
+		t.Errorf("user tenant_id = %q, want resource tenant %q", got, resourceTenantID)
+	}
+}
+
+func Test_newHTTPClient_transport(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:    "untrusted certificate",
+			raw:     map[string]interface{}{},
+			wantErr: true,
+		},
+		{
+			name: "trusted via ca_certificates",
+			raw:  map[string]interface{}{"ca_certificates": []interface{}{caPEM}},
+		},
+		{
+			name: "insecure_skip_verify",
+			raw:  map[string]interface{}{"insecure_skip_verify": true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hc, err := newHTTPClient(schema.TestResourceDataRaw(t, Provider().Schema, tt.raw))
+			if err != nil {
+				t.Fatalf("newHTTPClient: %s", err)
+			}
+
+			resp, err := hc.Get(srv.URL)
+			if resp != nil {
+				resp.Body.Close()
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GET err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	_, err := newHTTPClient(schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"ca_certificates": []interface{}{"not a certificate"},
+	}))
+	if err == nil {
+		t.Error("expected an error for an invalid CA bundle")
+	}
+}
+
+func Test_newRetryConfiguration(t *testing.T) {
+	tests := []struct {
+		name        string
+		enableRetry string
+		config      map[string]interface{}
+		want        *fusionauth.RetryConfiguration
+	}{
+		{
+			name:   "unset",
+			config: map[string]interface{}{},
+		},
+		{
+			name:   "configured",
+			config: map[string]interface{}{"max_retries": 3, "retry_wait_min_ms": 250, "retry_wait_max_ms": 5000},
+			want:   &fusionauth.RetryConfiguration{MaxRetries: 3, InitialDelay: 250 * time.Millisecond, MaxDelay: 5 * time.Second},
+		},
+		{
+			name:        "disabled although the environment enables them",
+			enableRetry: "true",
+			config:      map[string]interface{}{"max_retries": 0},
+			want:        &fusionauth.RetryConfiguration{MaxRetries: 0, InitialDelay: 100 * time.Millisecond, MaxDelay: 30 * time.Second},
+		},
+		{
+			name:        "enabled by the environment",
+			enableRetry: "true",
+			config:      map[string]interface{}{"retry_wait_min_ms": 250, "retry_wait_max_ms": 5000},
+			want:        &fusionauth.RetryConfiguration{MaxRetries: fusionauth.NewBasicRetryConfiguration().MaxRetries, InitialDelay: 250 * time.Millisecond, MaxDelay: 5 * time.Second},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("FUSIONAUTH_ENABLE_RETRY", tt.enableRetry)
+			t.Setenv("FA_MAX_RETRIES", "")
+
+			got := newRetryConfiguration(schema.TestResourceDataRaw(t, Provider().Schema, tt.config))
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("retry configuration = %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil || got.MaxRetries != tt.want.MaxRetries || got.InitialDelay != tt.want.InitialDelay || got.MaxDelay != tt.want.MaxDelay {
+				t.Errorf("retry configuration = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_waitForReady(t *testing.T) {
+	initial, maxBackoff := readyInitialBackoff, readyMaxBackoff
+	readyInitialBackoff, readyMaxBackoff = time.Millisecond, 5*time.Millisecond
+	t.Cleanup(func() { readyInitialBackoff, readyMaxBackoff = initial, maxBackoff })
+
+	newClient := func(readyAfter int32) fusionauth.FusionAuthClient {
+		var calls int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/status" || atomic.AddInt32(&calls, 1) <= readyAfter {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"status":"ok"}`))
+		}))
+		t.Cleanup(srv.Close)
+
+		hostURL, _ := url.Parse(srv.URL)
+		return *fusionauth.NewClient(srv.Client(), hostURL, "key")
+	}
+
+	if err := waitForReady(context.Background(), newClient(3), time.Second); err != nil {
+		t.Errorf("waitForReady: %s", err)
+	}
+
+	err := waitForReady(context.Background(), newClient(math.MaxInt32), 50*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "503") {
+		t.Errorf("waitForReady err = %v, want the last status reported", err)
+	}
+}
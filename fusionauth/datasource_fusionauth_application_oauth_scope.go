@@ -57,10 +57,10 @@ func dataSourceApplicationOAuthScope() *schema.Resource {
 	}
 }
 
-func dataSourceApplicationOAuthScopeRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func dataSourceApplicationOAuthScopeRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	aid := data.Get("application_id").(string)
-	resp, err := client.FAClient.RetrieveApplication(aid)
+	resp, err := client.FAClient.RetrieveApplicationWithContext(ctx, aid)
 	if err != nil {
 		return diag.FromErr(err)
 	}
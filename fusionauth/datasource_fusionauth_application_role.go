@@ -28,10 +28,10 @@ func dataSourceApplicationRole() *schema.Resource {
 	}
 }
 
-func dataSourceApplicationRoleRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func dataSourceApplicationRoleRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	aid := data.Get("application_id").(string)
-	resp, err := client.FAClient.RetrieveApplication(aid)
+	resp, err := client.FAClient.RetrieveApplicationWithContext(ctx, aid)
 	if err != nil {
 		return diag.FromErr(err)
 	}
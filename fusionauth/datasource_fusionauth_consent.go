@@ -100,11 +100,11 @@ func dataSourceConsent() *schema.Resource {
 	}
 }
 
-func dataSourceConsentRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func dataSourceConsentRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
 	// Retrieve consent based on provided identifiers
-	consent, diags := retrieveConsent(client, data)
+	consent, diags := retrieveConsent(ctx, client, data)
 	if diags != nil {
 		return diags
 	}
@@ -117,21 +117,21 @@ func dataSourceConsentRead(_ context.Context, data *schema.ResourceData, i inter
 }
 
 // retrieveConsent gets a consent using either ID or name
-func retrieveConsent(client Client, data *schema.ResourceData) (*fusionauth.Consent, diag.Diagnostics) {
+func retrieveConsent(ctx context.Context, client Client, data *schema.ResourceData) (*fusionauth.Consent, diag.Diagnostics) {
 	if id, ok := data.GetOk("consent_id"); ok {
-		return retrieveConsentByID(client, id.(string))
+		return retrieveConsentByID(ctx, client, id.(string))
 	}
 
 	if name, ok := data.GetOk("name"); ok {
-		return retrieveConsentByName(client, name.(string))
+		return retrieveConsentByName(ctx, client, name.(string))
 	}
 
 	return nil, diag.Errorf("Either 'consent_id' or 'name' must be specified")
 }
 
 // retrieveConsentByID retrieves a consent using its ID
-func retrieveConsentByID(client Client, id string) (*fusionauth.Consent, diag.Diagnostics) {
-	resp, err := client.FAClient.RetrieveConsent(id)
+func retrieveConsentByID(ctx context.Context, client Client, id string) (*fusionauth.Consent, diag.Diagnostics) {
+	resp, err := client.FAClient.RetrieveConsentWithContext(ctx, id)
 	if err != nil {
 		return nil, diag.Errorf("Error retrieving consent with id %s: %s", id, err)
 	}
@@ -148,8 +148,8 @@ func retrieveConsentByID(client Client, id string) (*fusionauth.Consent, diag.Di
 }
 
 // retrieveConsentByName retrieves a consent using its name
-func retrieveConsentByName(client Client, name string) (*fusionauth.Consent, diag.Diagnostics) {
-	consentsResp, err := client.FAClient.RetrieveConsents()
+func retrieveConsentByName(ctx context.Context, client Client, name string) (*fusionauth.Consent, diag.Diagnostics) {
+	consentsResp, err := client.FAClient.RetrieveConsentsWithContext(ctx)
 	if err != nil {
 		return nil, diag.Errorf("Error retrieving consents: %s", err)
 	}
@@ -162,7 +162,7 @@ func retrieveConsentByName(client Client, name string) (*fusionauth.Consent, dia
 	for _, c := range consentsResp.Consents {
 		if c.Name == name {
 			// Once found, get the full consent details
-			return retrieveConsentByID(client, c.Id)
+			return retrieveConsentByID(ctx, client, c.Id)
 		}
 	}
 
@@ -0,0 +1,150 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceEntity() *schema.Resource {
+	// The entity's attributes are those of the fusionauth_entity resource, so
+	// they are read with the resource's mapping.
+	s := dataSourceSchemaFromResourceSchema(resourceEntity().Schema)
+	s["entity_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"entity_id", "name"},
+		Description:  "The unique Id of the Entity to retrieve.",
+		ValidateFunc: validation.IsUUID,
+	}
+	s["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"entity_id", "name"},
+		Description:  "The name of the Entity to retrieve.",
+	}
+	s["tenant_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "The unique Id of the tenant used to scope this API request.",
+		ValidateFunc: validation.IsUUID,
+	}
+	s["entity_type_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "The Id of the Entity Type. When looking the Entity up by name, only Entities of this type are considered.",
+		ValidateFunc: validation.IsUUID,
+	}
+	s["include_client_secret"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether to read the Entity's OAuth 2.0 client secret into `client_secret`, which is left blank otherwise.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceEntityRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceEntityRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := tenantScopedClient(i.(Client), data)
+
+	var e fusionauth.Entity
+	if id, ok := data.GetOk("entity_id"); ok {
+		resp, faErrs, err := client.FAClient.RetrieveEntityWithContext(ctx, id.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			return diag.Errorf("couldn't find entity %s", id)
+		}
+		if err := checkResponse(resp.StatusCode, faErrs); err != nil {
+			return diag.FromErr(err)
+		}
+		e = resp.Entity
+	} else {
+		name := data.Get("name").(string)
+		entityTypeID := data.Get("entity_type_id").(string)
+		entities, err := searchEntities(ctx, client, name, entityTypeID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var found []fusionauth.Entity
+		for _, entity := range entities {
+			if entity.Name == name && (entityTypeID == "" || entity.Type.Id == entityTypeID) {
+				found = append(found, entity)
+			}
+		}
+		switch len(found) {
+		case 0:
+			return diag.Errorf("couldn't find entity %s", name)
+		case 1:
+			e = found[0]
+		default:
+			return diag.Errorf("found %d entities named %s. Set tenant_id or entity_type_id to choose one of them", len(found), name)
+		}
+	}
+
+	if !data.Get("include_client_secret").(bool) {
+		e.ClientSecret = ""
+	}
+
+	return entityResponseToData(data, &fusionauth.EntityResponse{Entity: e})
+}
+
+// searchEntities returns the entities visible to the client, i.e. those of
+// its tenant when it is scoped to one, whose name matches name and, when it is
+// set, whose type is entityTypeID. The name is matched as a phrase, so names
+// containing it match too and must be filtered out by the caller.
+func searchEntities(ctx context.Context, client Client, name, entityTypeID string) ([]fusionauth.Entity, error) {
+	const pageSize = 100
+
+	query := "name:" + entityQueryTerm(name)
+	if entityTypeID != "" {
+		query += " AND typeId:" + entityQueryTerm(entityTypeID)
+	}
+
+	var entities []fusionauth.Entity
+	for {
+		resp, faErrs, err := client.FAClient.SearchEntitiesWithContext(ctx, fusionauth.EntitySearchRequest{
+			Search: fusionauth.EntitySearchCriteria{
+				BaseElasticSearchCriteria: fusionauth.BaseElasticSearchCriteria{
+					BaseSearchCriteria: fusionauth.BaseSearchCriteria{
+						NumberOfResults: pageSize,
+						StartRow:        len(entities),
+					},
+					QueryString: query,
+				},
+			},
+		})
+		if err == nil {
+			err = checkResponse(resp.StatusCode, faErrs)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("searching entities: %w", err)
+		}
+
+		entities = append(entities, resp.Entities...)
+		if len(resp.Entities) < pageSize || int64(len(entities)) >= resp.Total {
+			return entities, nil
+		}
+	}
+}
+
+// entityQueryTerm quotes s as a phrase in an Elasticsearch query string.
+func entityQueryTerm(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,139 @@
+package fusionauth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Test_dataSourceEntityRead(t *testing.T) {
+	const (
+		otherTenantID = "6f1b6f5e-58a4-4c1f-a5f1-3c4c79f5a1f2"
+		apiClientID   = "1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e55"
+		deviceID      = "9f8e7d6c-5b4a-4392-8170-6f5e4d3c2b66"
+		reportingID   = "2a5c0b65-5bba-4a0b-8c4b-0d3f2f7e9d11"
+		otherID       = "0e6f1b8c-9a55-4e59-b2a2-0b7a1f8c3d22"
+		deviceTypeID  = "5b0c7c52-2d24-4a59-9a3b-2b9f8b2c4e33"
+	)
+
+	fake, client := newFakeFusionAuthClient(t)
+	ctx := context.Background()
+
+	fake.seed("tenant", map[string]interface{}{"id": otherTenantID, "name": "Other"})
+	fake.seed("entity", map[string]interface{}{
+		"id":           reportingID,
+		"name":         "Reporting",
+		"tenantId":     fakeDefaultTenantID,
+		"clientId":     "reporting",
+		"clientSecret": "reporting-secret",
+		"type":         map[string]interface{}{"id": apiClientID},
+	})
+	fake.seed("entity", map[string]interface{}{"id": "8d6e3a1f-6c3b-4f0e-8f5d-4a2b9c1d7e44", "name": "Reporting", "tenantId": fakeDefaultTenantID, "type": map[string]interface{}{"id": deviceID}})
+	fake.seed("entity", map[string]interface{}{"id": otherID, "name": "Reporting", "tenantId": otherTenantID, "type": map[string]interface{}{"id": apiClientID}})
+
+	tests := []struct {
+		name       string
+		config     map[string]interface{}
+		wantSecret string
+	}{
+		{
+			name:   "by id",
+			config: map[string]interface{}{"entity_id": reportingID},
+		},
+		{
+			name:       "by id with the secret",
+			config:     map[string]interface{}{"entity_id": reportingID, "include_client_secret": true},
+			wantSecret: "reporting-secret",
+		},
+		{
+			name:   "by tenant, type and name",
+			config: map[string]interface{}{"tenant_id": fakeDefaultTenantID, "entity_type_id": apiClientID, "name": "Reporting"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := schema.TestResourceDataRaw(t, dataSourceEntity().Schema, tt.config)
+			if diags := dataSourceEntityRead(ctx, data, client); diags.HasError() {
+				t.Fatalf("dataSourceEntityRead: %v", diags)
+			}
+
+			for k, want := range map[string]interface{}{
+				"entity_id":      reportingID,
+				"name":           "Reporting",
+				"tenant_id":      fakeDefaultTenantID,
+				"entity_type_id": apiClientID,
+				"client_id":      "reporting",
+				"client_secret":  tt.wantSecret,
+			} {
+				if got := data.Get(k); got != want {
+					t.Errorf("%s = %v, want %v", k, got, want)
+				}
+			}
+			if data.Id() != reportingID {
+				t.Errorf("Id = %s, want %s", data.Id(), reportingID)
+			}
+		})
+	}
+
+	for name, config := range map[string]map[string]interface{}{
+		"an ambiguous name": {"tenant_id": fakeDefaultTenantID, "name": "Reporting"},
+		"a missing name":    {"tenant_id": otherTenantID, "entity_type_id": deviceTypeID, "name": "Reporting"},
+	} {
+		data := schema.TestResourceDataRaw(t, dataSourceEntity().Schema, config)
+		if diags := dataSourceEntityRead(ctx, data, client); !diags.HasError() {
+			t.Errorf("reading an entity by %s succeeded", name)
+		}
+	}
+}
+
+func Test_searchEntities_query(t *testing.T) {
+	const typeID = "5b0c7c52-2d24-4a59-9a3b-2b9f8b2c4e33"
+
+	fake := newFakeFusionAuth(fakeFusionAuthAPIKey)
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/entity/search" {
+			body, _ := io.ReadAll(r.Body)
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			var req fusionauth.EntitySearchRequest
+			if err := json.Unmarshal(body, &req); err != nil {
+				t.Errorf("decoding the search request: %v", err)
+			}
+			queries = append(queries, req.Search.QueryString)
+		}
+		fake.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	hostURL, _ := url.Parse(srv.URL)
+	client := Client{
+		Host:     srv.URL,
+		APIKey:   fakeFusionAuthAPIKey,
+		FAClient: *fusionauth.NewClient(srv.Client(), hostURL, fakeFusionAuthAPIKey),
+	}
+
+	tests := []struct {
+		name, entityTypeID, want string
+	}{
+		{name: "Reporting", want: `name:"Reporting"`},
+		{name: "Reporting", entityTypeID: typeID, want: `name:"Reporting" AND typeId:"` + typeID + `"`},
+		{name: `Say "hi" \ bye`, want: `name:"Say \"hi\" \\ bye"`},
+	}
+	for _, tt := range tests {
+		queries = nil
+		if _, err := searchEntities(context.Background(), client, tt.name, tt.entityTypeID); err != nil {
+			t.Fatalf("searchEntities: %v", err)
+		}
+		if len(queries) != 1 || queries[0] != tt.want {
+			t.Errorf("searchEntities(%q, %q) sent %q, want [%q]", tt.name, tt.entityTypeID, queries, tt.want)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package fusionauth
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceEntityType() *schema.Resource {
+	// The entity type's attributes are those of the fusionauth_entity_type
+	// resource, so they are read with the resource's mapping.
+	s := dataSourceSchemaFromResourceSchema(resourceEntityType().Schema)
+	s["entity_type_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"entity_type_id", "name"},
+		Description:  "The unique Id of the Entity Type to retrieve.",
+		ValidateFunc: validation.IsUUID,
+	}
+	s["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"entity_type_id", "name"},
+		Description:  "The name of the Entity Type to retrieve.",
+	}
+	s["permissions"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The Permissions defined on the Entity Type, ordered by name.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"permission_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The unique Id of the Permission.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the Permission.",
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The description of the Permission.",
+				},
+				"is_default": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether or not the Permission is granted when a grant request provides no permissions.",
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceEntityTypeRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceEntityTypeRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	var t fusionauth.EntityType
+	if id, ok := data.GetOk("entity_type_id"); ok {
+		resp, faErrs, err := client.FAClient.RetrieveEntityTypeWithContext(ctx, id.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			return diag.Errorf("couldn't find entity type %s", id)
+		}
+		if err := checkResponse(resp.StatusCode, faErrs); err != nil {
+			return diag.FromErr(err)
+		}
+		t = resp.EntityType
+	} else {
+		resp, faErrs, err := client.FAClient.RetrieveEntityTypesWithContext(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := checkResponse(resp.StatusCode, faErrs); err != nil {
+			return diag.FromErr(err)
+		}
+		name := data.Get("name").(string)
+
+		found := false
+		for _, entityType := range resp.EntityTypes {
+			if entityType.Name == name {
+				t, found = entityType, true
+				break
+			}
+		}
+		if !found {
+			return diag.Errorf("couldn't find entity type %s", name)
+		}
+	}
+
+	if diags := entityTypeResponseToData(data, &fusionauth.EntityTypeResponse{EntityType: t}); diags.HasError() {
+		return diags
+	}
+
+	permissions := make([]map[string]interface{}, 0, len(t.Permissions))
+	for _, p := range t.Permissions {
+		permissions = append(permissions, map[string]interface{}{
+			"permission_id": p.Id,
+			"name":          p.Name,
+			"description":   p.Description,
+			"is_default":    p.IsDefault,
+		})
+	}
+	sort.Slice(permissions, func(i, j int) bool {
+		return permissions[i]["name"].(string) < permissions[j]["name"].(string)
+	})
+	if err := data.Set("permissions", permissions); err != nil {
+		return diag.Errorf("entity_type.permissions: %s", err.Error())
+	}
+
+	return nil
+}
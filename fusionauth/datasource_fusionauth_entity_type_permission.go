@@ -0,0 +1,74 @@
+package fusionauth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceEntityTypePermission() *schema.Resource {
+	// The permission's attributes are those of the
+	// fusionauth_entity_type_permission resource, so they are read with the
+	// resource's mapping.
+	s := dataSourceSchemaFromResourceSchema(resourceEntityTypePermission().Schema)
+	s["entity_type_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The Id of the Entity Type the Permission is defined on.",
+		ValidateFunc: validation.IsUUID,
+	}
+	s["permission_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"name", "permission_id"},
+		Description:  "The unique Id of the Permission to retrieve.",
+		ValidateFunc: validation.IsUUID,
+	}
+	s["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"name", "permission_id"},
+		Description:  "The name of the Permission to retrieve.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceEntityTypePermissionRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceEntityTypePermissionRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	// Permissions are only returned as part of their entity type.
+	entityTypeID := data.Get("entity_type_id").(string)
+	resp, faErrs, err := client.FAClient.RetrieveEntityTypeWithContext(ctx, entityTypeID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return diag.Errorf("couldn't find entity type %s", entityTypeID)
+	}
+	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := data.Get("permission_id").(string)
+	name := data.Get("name").(string)
+	for _, p := range resp.EntityType.Permissions {
+		if (id != "" && p.Id == id) || (id == "" && p.Name == name) {
+			return entityTypePermissionResponseToData(data, entityTypeID, &fusionauth.EntityTypeResponse{Permission: p})
+		}
+	}
+
+	if id != "" {
+		return diag.Errorf("couldn't find permission %s of entity type %s", id, entityTypeID)
+	}
+	return diag.Errorf("couldn't find permission %s of entity type %s", name, entityTypeID)
+}
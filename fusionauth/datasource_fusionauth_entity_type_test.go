@@ -0,0 +1,119 @@
+package fusionauth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	testEntityTypeID  = "5b0c7c52-2d24-4a59-9a3b-2b9f8b2c4e33"
+	testReadPermID    = "6e7f8091-a2b3-4c45-96e7-f8091a2b3caa"
+	testWritePermID   = "7f8091a2-b3c4-4d56-a7f8-091a2b3c4dbb"
+	testEntityTypeKey = "3b4c5d6e-7f80-4912-a3b4-c5d6e7f80977"
+)
+
+func seedTestEntityType(fake *fakeFusionAuth) {
+	fake.seed("entityType", map[string]interface{}{
+		"id":   testEntityTypeID,
+		"name": "API client",
+		"data": map[string]interface{}{"owner": "platform"},
+		"jwtConfiguration": map[string]interface{}{
+			"enabled":             true,
+			"accessTokenKeyId":    testEntityTypeKey,
+			"timeToLiveInSeconds": 3600,
+		},
+		"permissions": []interface{}{
+			map[string]interface{}{"id": testWritePermID, "name": "write", "description": "Write access"},
+			map[string]interface{}{"id": testReadPermID, "name": "read", "isDefault": true},
+		},
+	})
+}
+
+func Test_dataSourceEntityTypeRead(t *testing.T) {
+	fake, client := newFakeFusionAuthClient(t)
+	ctx := context.Background()
+	seedTestEntityType(fake)
+
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+	}{
+		{name: "by id", config: map[string]interface{}{"entity_type_id": testEntityTypeID}},
+		{name: "by name", config: map[string]interface{}{"name": "API client"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := schema.TestResourceDataRaw(t, dataSourceEntityType().Schema, tt.config)
+			if diags := dataSourceEntityTypeRead(ctx, data, client); diags.HasError() {
+				t.Fatalf("dataSourceEntityTypeRead: %v", diags)
+			}
+
+			for k, want := range map[string]interface{}{
+				"entity_type_id":              testEntityTypeID,
+				"name":                        "API client",
+				"jwt_configuration.0.enabled": true,
+				"jwt_configuration.0.access_token_key_id":     testEntityTypeKey,
+				"jwt_configuration.0.time_to_live_in_seconds": 3600,
+				"permissions.#":               2,
+				"permissions.0.name":          "read",
+				"permissions.0.permission_id": testReadPermID,
+				"permissions.0.is_default":    true,
+				"permissions.1.name":          "write",
+				"permissions.1.description":   "Write access",
+			} {
+				if got := data.Get(k); got != want {
+					t.Errorf("%s = %v, want %v", k, got, want)
+				}
+			}
+		})
+	}
+
+	data := schema.TestResourceDataRaw(t, dataSourceEntityType().Schema, map[string]interface{}{"name": "Device"})
+	if diags := dataSourceEntityTypeRead(ctx, data, client); !diags.HasError() {
+		t.Error("reading a missing entity type succeeded")
+	}
+}
+
+func Test_dataSourceEntityTypePermissionRead(t *testing.T) {
+	fake, client := newFakeFusionAuthClient(t)
+	ctx := context.Background()
+	seedTestEntityType(fake)
+
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+	}{
+		{name: "by id", config: map[string]interface{}{"entity_type_id": testEntityTypeID, "permission_id": testWritePermID}},
+		{name: "by name", config: map[string]interface{}{"entity_type_id": testEntityTypeID, "name": "write"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := schema.TestResourceDataRaw(t, dataSourceEntityTypePermission().Schema, tt.config)
+			if diags := dataSourceEntityTypePermissionRead(ctx, data, client); diags.HasError() {
+				t.Fatalf("dataSourceEntityTypePermissionRead: %v", diags)
+			}
+
+			for k, want := range map[string]interface{}{
+				"entity_type_id": testEntityTypeID,
+				"permission_id":  testWritePermID,
+				"name":           "write",
+				"description":    "Write access",
+				"is_default":     false,
+			} {
+				if got := data.Get(k); got != want {
+					t.Errorf("%s = %v, want %v", k, got, want)
+				}
+			}
+			if data.Id() != testWritePermID {
+				t.Errorf("Id = %s, want %s", data.Id(), testWritePermID)
+			}
+		})
+	}
+
+	data := schema.TestResourceDataRaw(t, dataSourceEntityTypePermission().Schema, map[string]interface{}{"entity_type_id": testEntityTypeID, "name": "delete"})
+	if diags := dataSourceEntityTypePermissionRead(ctx, data, client); !diags.HasError() {
+		t.Error("reading a missing permission succeeded")
+	}
+}
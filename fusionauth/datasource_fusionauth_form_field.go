@@ -125,7 +125,7 @@ func dataSourceFormField() *schema.Resource {
 	}
 }
 
-func dataSourceFormFieldRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func dataSourceFormFieldRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
 	var searchTerm string
@@ -135,10 +135,10 @@ func dataSourceFormFieldRead(_ context.Context, data *schema.ResourceData, i int
 	// Either `form_field_id` or `name` are guaranteed to be set
 	if entityID, ok := data.GetOk("form_field_id"); ok {
 		searchTerm = entityID.(string)
-		res, err = client.FAClient.RetrieveFormField(searchTerm)
+		res, err = client.FAClient.RetrieveFormFieldWithContext(ctx, searchTerm)
 	} else {
 		searchTerm = data.Get("name").(string)
-		res, err = client.FAClient.RetrieveFormFields()
+		res, err = client.FAClient.RetrieveFormFieldsWithContext(ctx)
 	}
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,167 @@
+package fusionauth
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGroupRead,
+		Schema: map[string]*schema.Schema{
+			// Data Source Parameters
+			"group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"group_id", "name"},
+				Description:  "The unique Id of the Group to retrieve.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"group_id", "name"},
+				Description:  "The name of the Group to retrieve.",
+			},
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique Id of the tenant used to scope this API request.",
+				ValidateFunc: validation.IsUUID,
+			},
+			// Data Source Attributes
+			"data": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An object that can hold any information about the Group that should be persisted. Must be a JSON string.",
+			},
+			"role_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The Ids of the Application Roles assigned to the Group.",
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Application Roles assigned to the Group, ordered by application and name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique Id of the Application the role belongs to.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the role.",
+						},
+						"role_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique Id of the role.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := tenantScopedClient(i.(Client), data)
+
+	var g fusionauth.Group
+	if id, ok := data.GetOk("group_id"); ok {
+		resp, faErrs, err := client.FAClient.RetrieveGroupWithContext(ctx, id.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			return diag.Errorf("couldn't find group %s", id)
+		}
+		if err := checkResponse(resp.StatusCode, faErrs); err != nil {
+			return diag.FromErr(err)
+		}
+		g = resp.Group
+	} else {
+		resp, err := client.FAClient.RetrieveGroupsWithContext(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := checkResponse(resp.StatusCode, nil); err != nil {
+			return diag.FromErr(err)
+		}
+		name := data.Get("name").(string)
+
+		var found []fusionauth.Group
+		for _, group := range resp.Groups {
+			if group.Name == name {
+				found = append(found, group)
+			}
+		}
+		switch len(found) {
+		case 0:
+			return diag.Errorf("couldn't find group %s", name)
+		case 1:
+			g = found[0]
+		default:
+			return diag.Errorf("found %d groups named %s. Set tenant_id to choose one of them", len(found), name)
+		}
+	}
+
+	data.SetId(g.Id)
+	if err := data.Set("group_id", g.Id); err != nil {
+		return diag.Errorf("group.group_id: %s", err.Error())
+	}
+	if err := data.Set("name", g.Name); err != nil {
+		return diag.Errorf("group.name: %s", err.Error())
+	}
+	if err := data.Set("tenant_id", g.TenantId); err != nil {
+		return diag.Errorf("group.tenant_id: %s", err.Error())
+	}
+	dataJSON, diags := mapStringInterfaceToJSONString(g.Data)
+	if diags != nil {
+		return diags
+	}
+	if err := data.Set("data", dataJSON); err != nil {
+		return diag.Errorf("group.data: %s", err.Error())
+	}
+
+	var roleIDs []string
+	var roles []map[string]interface{}
+	for applicationID, applicationRoles := range g.Roles {
+		for _, r := range applicationRoles {
+			roleIDs = append(roleIDs, r.Id)
+			roles = append(roles, map[string]interface{}{
+				"application_id": applicationID,
+				"name":           r.Name,
+				"role_id":        r.Id,
+			})
+		}
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		if roles[i]["application_id"] != roles[j]["application_id"] {
+			return roles[i]["application_id"].(string) < roles[j]["application_id"].(string)
+		}
+		return roles[i]["name"].(string) < roles[j]["name"].(string)
+	})
+	if err := data.Set("role_ids", roleIDs); err != nil {
+		return diag.Errorf("group.role_ids: %s", err.Error())
+	}
+	if err := data.Set("roles", roles); err != nil {
+		return diag.Errorf("group.roles: %s", err.Error())
+	}
+
+	return nil
+}
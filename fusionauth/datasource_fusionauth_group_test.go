@@ -0,0 +1,92 @@
+package fusionauth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Test_dataSourceGroupRead(t *testing.T) {
+	const (
+		otherTenantID  = "6f1b6f5e-58a4-4c1f-a5f1-3c4c79f5a1f2"
+		adminsID       = "3b4c5d6e-7f80-4912-a3b4-c5d6e7f80977"
+		otherAdminsID  = "4c5d6e7f-8091-4a23-b4c5-d6e7f8091a88"
+		otherAppID     = "0e6f1b8c-9a55-4e59-b2a2-0b7a1f8c3d22"
+		adminRoleID    = "5d6e7f80-91a2-4b34-85d6-e7f8091a2b99"
+		viewerRoleID   = "6e7f8091-a2b3-4c45-96e7-f8091a2b3caa"
+		otherAppRoleID = "7f8091a2-b3c4-4d56-a7f8-091a2b3c4dbb"
+	)
+
+	fake, client := newFakeFusionAuthClient(t)
+	ctx := context.Background()
+
+	fake.seed("tenant", map[string]interface{}{"id": otherTenantID, "name": "Other"})
+	fake.seed("group", map[string]interface{}{
+		"id":       adminsID,
+		"name":     "Admins",
+		"tenantId": fakeDefaultTenantID,
+		"data":     map[string]interface{}{"owner": "ops"},
+		"roles": map[string]interface{}{
+			otherAppID: []interface{}{
+				map[string]interface{}{"id": otherAppRoleID, "name": "admin"},
+			},
+			fakeDefaultApplicationID: []interface{}{
+				map[string]interface{}{"id": viewerRoleID, "name": "viewer"},
+				map[string]interface{}{"id": adminRoleID, "name": "admin"},
+			},
+		},
+	})
+	fake.seed("group", map[string]interface{}{"id": otherAdminsID, "name": "Admins", "tenantId": otherTenantID})
+
+	wantData, _ := mapStringInterfaceToJSONString(map[string]interface{}{"owner": "ops"})
+
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+	}{
+		{name: "by id", config: map[string]interface{}{"group_id": adminsID}},
+		{name: "by tenant and name", config: map[string]interface{}{"tenant_id": fakeDefaultTenantID, "name": "Admins"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := schema.TestResourceDataRaw(t, dataSourceGroup().Schema, tt.config)
+			if diags := dataSourceGroupRead(ctx, data, client); diags.HasError() {
+				t.Fatalf("dataSourceGroupRead: %v", diags)
+			}
+
+			for k, want := range map[string]interface{}{
+				"group_id":               adminsID,
+				"name":                   "Admins",
+				"tenant_id":              fakeDefaultTenantID,
+				"data":                   wantData,
+				"role_ids.#":             3,
+				"roles.#":                3,
+				"roles.0.application_id": otherAppID,
+				"roles.0.role_id":        otherAppRoleID,
+				"roles.1.application_id": fakeDefaultApplicationID,
+				"roles.1.name":           "admin",
+				"roles.1.role_id":        adminRoleID,
+				"roles.2.name":           "viewer",
+				"roles.2.role_id":        viewerRoleID,
+			} {
+				if got := data.Get(k); got != want {
+					t.Errorf("%s = %v, want %v", k, got, want)
+				}
+			}
+			if data.Id() != adminsID {
+				t.Errorf("Id = %s, want %s", data.Id(), adminsID)
+			}
+		})
+	}
+
+	data := schema.TestResourceDataRaw(t, dataSourceGroup().Schema, map[string]interface{}{"name": "Admins"})
+	if diags := dataSourceGroupRead(ctx, data, client); !diags.HasError() {
+		t.Error("reading a group name used in two tenants succeeded")
+	}
+
+	data = schema.TestResourceDataRaw(t, dataSourceGroup().Schema, map[string]interface{}{"name": "Missing"})
+	if diags := dataSourceGroupRead(ctx, data, client); !diags.HasError() {
+		t.Error("reading a missing group succeeded")
+	}
+}
@@ -3,11 +3,7 @@ package fusionauth
 import (
 	"context"
 	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
-	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -53,9 +49,9 @@ func dataSourceIDP() *schema.Resource {
 	}
 }
 
-func dataSourceIDPRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func dataSourceIDPRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	b, err := readIdentityProviders(client)
+	b, err := readIdentityProviders(ctx, client)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -83,33 +79,14 @@ func dataSourceIDPRead(_ context.Context, data *schema.ResourceData, i interface
 	return nil
 }
 
-func readIdentityProviders(client Client) ([]byte, error) {
-	req, err := http.NewRequest(
-		http.MethodGet,
-		fmt.Sprintf("%s/%s", strings.TrimRight(client.Host, "/"), "api/identity-provider"),
-		nil,
-	)
-
+func readIdentityProviders(ctx context.Context, client Client) ([]byte, error) {
+	resp, faErrs, err := doRawRequest(ctx, client, http.MethodGet, "/api/identity-provider", "", nil)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Authorization", client.APIKey)
-
-	hc := http.Client{
-		Timeout: 30 * time.Second,
-	}
-
-	resp, err := hc.Do(req)
-	if err != nil {
+	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	b, _ := io.ReadAll(resp.Body)
-
-	if err := checkResponse(resp.StatusCode, nil); err != nil {
-		return b, err
-	}
-	return b, nil
+	return resp.Body, nil
 }
@@ -0,0 +1,131 @@
+package fusionauth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceIPAccessControlList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIPAccessControlListRead,
+		Schema: map[string]*schema.Schema{
+			// Data Source Parameters
+			"ip_access_control_list_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"ip_access_control_list_id", "name"},
+				Description:  "The unique Id of the IP Access Control List to retrieve.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"ip_access_control_list_id", "name"},
+				Description:  "The name of the IP Access Control List to retrieve.",
+			},
+			// Data Source Attributes
+			"data": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An object that can hold any information about the IP Access Control List that should be persisted. Must be a JSON string.",
+			},
+			"entry": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IP ranges and the action taken for requests from them, in order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The action taken for requests from the range, Allow or Block.",
+						},
+						"start_ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The first IP address of the range, or * for every address.",
+						},
+						"end_ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The last IP address of the range, inclusive, or * for every address.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIPAccessControlListRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	var acl fusionauth.IPAccessControlList
+	if id, ok := data.GetOk("ip_access_control_list_id"); ok {
+		resp, err := client.FAClient.RetrieveIPAccessControlListWithContext(ctx, id.(string))
+		if err != nil {
+			return diag.Errorf("Error retrieving IP access control list with id %s: %s", id, err)
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			return diag.Errorf("Couldn't find IP access control list with id '%s'", id)
+		}
+		if err := checkResponse(resp.StatusCode, nil); err != nil {
+			return diag.FromErr(err)
+		}
+		acl = resp.IpAccessControlList
+	} else {
+		name := data.Get("name").(string)
+		acls, err := searchIPAccessControlLists(ctx, client, name)
+		if err != nil {
+			return diag.Errorf("Error searching IP access control lists: %s", err)
+		}
+
+		// The search matches names containing name, so look for the exact one.
+		found := false
+		for _, a := range acls {
+			if a.Name == name {
+				acl, found = a, true
+				break
+			}
+		}
+		if !found {
+			return diag.Errorf("Couldn't find IP access control list with name '%s'", name)
+		}
+	}
+
+	data.SetId(acl.Id)
+	if err := data.Set("ip_access_control_list_id", acl.Id); err != nil {
+		return diag.Errorf("ipAccessControlList.ip_access_control_list_id: %s", err.Error())
+	}
+	if err := data.Set("name", acl.Name); err != nil {
+		return diag.Errorf("ipAccessControlList.name: %s", err.Error())
+	}
+	dataJSON, diags := mapStringInterfaceToJSONString(acl.Data)
+	if diags != nil {
+		return diags
+	}
+	if err := data.Set("data", dataJSON); err != nil {
+		return diag.Errorf("ipAccessControlList.data: %s", err.Error())
+	}
+
+	entries := make([]interface{}, 0, len(acl.Entries))
+	for _, e := range acl.Entries {
+		entries = append(entries, map[string]interface{}{
+			"action":           string(e.Action),
+			"start_ip_address": e.StartIPAddress,
+			"end_ip_address":   e.EndIPAddress,
+		})
+	}
+	if err := data.Set("entry", entries); err != nil {
+		return diag.Errorf("ipAccessControlList.entry: %s", err.Error())
+	}
+
+	return nil
+}
@@ -79,11 +79,11 @@ func dataSourceLambda() *schema.Resource {
 	}
 }
 
-func dataSourceLambdaRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func dataSourceLambdaRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
 	lambdaType := data.Get("type").(string)
-	resp, err := client.FAClient.RetrieveLambdasByType(fusionauth.LambdaType(lambdaType))
+	resp, err := client.FAClient.RetrieveLambdasByTypeWithContext(ctx, fusionauth.LambdaType(lambdaType))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,548 @@
+package fusionauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// listDataSource describes a data source that lists every object of a type,
+// optionally filtered by name, tenant and type.
+type listDataSource struct {
+	// attribute is the name of the list of objects, e.g. "applications".
+	attribute string
+	// kind names the objects in descriptions, e.g. "Application".
+	kind string
+	// nameAttribute is the attribute the name filter matches, "name" unless
+	// the objects have none.
+	nameAttribute string
+	// tenantScoped objects can be filtered by tenant_id.
+	tenantScoped bool
+	// typed objects can be filtered by type, one of types when set.
+	typed bool
+	types []string
+	// elem holds the attributes of each object other than id.
+	elem map[string]*schema.Schema
+	list func(ctx context.Context, client Client) ([]listedObject, error)
+}
+
+// listedObject is an object returned by a listDataSource.
+type listedObject struct {
+	id   string
+	name string
+	typ  string
+	// tenantIDs are the tenants the object belongs to. Global objects belong
+	// to every tenant.
+	tenantIDs []string
+	global    bool
+	// attributes are the values of the listDataSource's elem.
+	attributes map[string]interface{}
+}
+
+func newListDataSource(l listDataSource) *schema.Resource {
+	if l.nameAttribute == "" {
+		l.nameAttribute = "name"
+	}
+
+	elem := map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique Id of the " + l.kind + ".",
+		},
+	}
+	for k, s := range l.elem {
+		elem[k] = s
+	}
+
+	s := map[string]*schema.Schema{
+		// Data Source Parameters
+		l.nameAttribute + "_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "A regular expression the " + l.nameAttribute + " of the returned " + l.kind + "s must match.",
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		// Data Source Attributes
+		"ids": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The Ids of the matching " + l.kind + "s.",
+		},
+		l.attribute: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Resource{Schema: elem},
+			Description: "The matching " + l.kind + "s, ordered by " + l.nameAttribute + ".",
+		},
+	}
+	if l.tenantScoped {
+		s["tenant_id"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Only return the " + l.kind + "s of this tenant.",
+			ValidateFunc: validation.IsUUID,
+		}
+	}
+	if l.typed {
+		validate := validation.StringIsNotEmpty
+		if l.types != nil {
+			validate = validation.StringInSlice(l.types, false)
+		}
+		s["type"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Only return the " + l.kind + "s of this type.",
+			ValidateFunc: validate,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			return readListDataSource(ctx, data, i.(Client), l)
+		},
+		Schema: s,
+	}
+}
+
+func readListDataSource(ctx context.Context, data *schema.ResourceData, client Client, l listDataSource) diag.Diagnostics {
+	if l.tenantScoped {
+		// The provider's tenant would hide the objects of any other tenant.
+		client = tenantScopedClient(client, data)
+	}
+	objects, err := l.list(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := data.GetOk(l.nameAttribute + "_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	tenantID, _ := data.Get("tenant_id").(string)
+	typ, _ := data.Get("type").(string)
+
+	var matched []listedObject
+	for _, o := range objects {
+		if nameRegex != nil && !nameRegex.MatchString(o.name) {
+			continue
+		}
+		if tenantID != "" && !o.global && !contains(o.tenantIDs, tenantID) {
+			continue
+		}
+		if typ != "" && o.typ != typ {
+			continue
+		}
+		matched = append(matched, o)
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		if matched[i].name != matched[j].name {
+			return matched[i].name < matched[j].name
+		}
+		return matched[i].id < matched[j].id
+	})
+
+	ids := make([]string, 0, len(matched))
+	values := make([]interface{}, 0, len(matched))
+	for _, o := range matched {
+		ids = append(ids, o.id)
+
+		value := map[string]interface{}{"id": o.id}
+		for k, v := range o.attributes {
+			value[k] = v
+		}
+		values = append(values, value)
+	}
+
+	data.SetId(l.attribute)
+	if err := data.Set("ids", ids); err != nil {
+		return diag.Errorf("%s.ids: %s", l.attribute, err.Error())
+	}
+	if err := data.Set(l.attribute, values); err != nil {
+		return diag.Errorf("%s.%s: %s", l.attribute, l.attribute, err.Error())
+	}
+
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// computedString returns a computed string attribute of a listed object.
+func computedString(description string) *schema.Schema {
+	return &schema.Schema{Type: schema.TypeString, Computed: true, Description: description}
+}
+
+// computedBool returns a computed boolean attribute of a listed object.
+func computedBool(description string) *schema.Schema {
+	return &schema.Schema{Type: schema.TypeBool, Computed: true, Description: description}
+}
+
+func dataSourceTenants() *schema.Resource {
+	return newListDataSource(listDataSource{
+		attribute: "tenants",
+		kind:      "Tenant",
+		elem: map[string]*schema.Schema{
+			"name":     computedString("The name of the Tenant."),
+			"issuer":   computedString("The named device that generated the JWTs of the Tenant, e.g. its URL."),
+			"theme_id": computedString("The unique Id of the theme used by the Tenant."),
+		},
+		list: func(ctx context.Context, client Client) ([]listedObject, error) {
+			resp, err := client.FAClient.RetrieveTenantsWithContext(ctx)
+			if err == nil {
+				err = checkResponse(resp.StatusCode, nil)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("listing tenants: %w", err)
+			}
+
+			objects := make([]listedObject, 0, len(resp.Tenants))
+			for _, t := range resp.Tenants {
+				objects = append(objects, listedObject{
+					id:   t.Id,
+					name: t.Name,
+					attributes: map[string]interface{}{
+						"name":     t.Name,
+						"issuer":   t.Issuer,
+						"theme_id": t.ThemeId,
+					},
+				})
+			}
+			return objects, nil
+		},
+	})
+}
+
+func dataSourceApplications() *schema.Resource {
+	return newListDataSource(listDataSource{
+		attribute:    "applications",
+		kind:         "Application",
+		tenantScoped: true,
+		elem: map[string]*schema.Schema{
+			"name":      computedString("The name of the Application."),
+			"tenant_id": computedString("The unique Id of the tenant the Application belongs to."),
+			"active":    computedBool("Whether the Application is active."),
+		},
+		list: func(ctx context.Context, client Client) ([]listedObject, error) {
+			resp, err := client.FAClient.RetrieveApplicationsWithContext(ctx)
+			if err == nil {
+				err = checkResponse(resp.StatusCode, nil)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("listing applications: %w", err)
+			}
+
+			objects := make([]listedObject, 0, len(resp.Applications))
+			for _, a := range resp.Applications {
+				objects = append(objects, listedObject{
+					id:        a.Id,
+					name:      a.Name,
+					tenantIDs: []string{a.TenantId},
+					attributes: map[string]interface{}{
+						"name":      a.Name,
+						"tenant_id": a.TenantId,
+						"active":    a.Active,
+					},
+				})
+			}
+			return objects, nil
+		},
+	})
+}
+
+func dataSourceLambdas() *schema.Resource {
+	return newListDataSource(listDataSource{
+		attribute: "lambdas",
+		kind:      "Lambda",
+		typed:     true,
+		elem: map[string]*schema.Schema{
+			"name":  computedString("The name of the Lambda."),
+			"type":  computedString("The Lambda type."),
+			"debug": computedBool("Whether or not debug event logging is enabled for the Lambda."),
+		},
+		list: func(ctx context.Context, client Client) ([]listedObject, error) {
+			resp, err := client.FAClient.RetrieveLambdasWithContext(ctx)
+			if err == nil {
+				err = checkResponse(resp.StatusCode, nil)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("listing lambdas: %w", err)
+			}
+
+			objects := make([]listedObject, 0, len(resp.Lambdas))
+			for _, l := range resp.Lambdas {
+				objects = append(objects, listedObject{
+					id:   l.Id,
+					name: l.Name,
+					typ:  string(l.Type),
+					attributes: map[string]interface{}{
+						"name":  l.Name,
+						"type":  string(l.Type),
+						"debug": l.Debug,
+					},
+				})
+			}
+			return objects, nil
+		},
+	})
+}
+
+func dataSourceKeys() *schema.Resource {
+	return newListDataSource(listDataSource{
+		attribute: "keys",
+		kind:      "Key",
+		typed:     true,
+		types: []string{
+			string(fusionauth.KeyType_EC),
+			string(fusionauth.KeyType_HMAC),
+			string(fusionauth.KeyType_OKP),
+			string(fusionauth.KeyType_RSA),
+		},
+		elem: map[string]*schema.Schema{
+			"name":      computedString("The name of the Key."),
+			"type":      computedString("The type of the Key, e.g. EC, HMAC, OKP or RSA."),
+			"algorithm": computedString("The algorithm of the Key."),
+			"kid":       computedString("The id used in the JWT header to identify the Key."),
+		},
+		list: func(ctx context.Context, client Client) ([]listedObject, error) {
+			resp, err := client.FAClient.RetrieveKeysWithContext(ctx)
+			if err == nil {
+				err = checkResponse(resp.StatusCode, nil)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("listing keys: %w", err)
+			}
+
+			objects := make([]listedObject, 0, len(resp.Keys))
+			for _, k := range resp.Keys {
+				objects = append(objects, listedObject{
+					id:   k.Id,
+					name: k.Name,
+					typ:  string(k.Type),
+					attributes: map[string]interface{}{
+						"name":      k.Name,
+						"type":      string(k.Type),
+						"algorithm": string(k.Algorithm),
+						"kid":       k.Kid,
+					},
+				})
+			}
+			return objects, nil
+		},
+	})
+}
+
+func dataSourceGroups() *schema.Resource {
+	return newListDataSource(listDataSource{
+		attribute:    "groups",
+		kind:         "Group",
+		tenantScoped: true,
+		elem: map[string]*schema.Schema{
+			"name":      computedString("The name of the Group."),
+			"tenant_id": computedString("The unique Id of the tenant the Group belongs to."),
+		},
+		list: func(ctx context.Context, client Client) ([]listedObject, error) {
+			resp, err := client.FAClient.RetrieveGroupsWithContext(ctx)
+			if err == nil {
+				err = checkResponse(resp.StatusCode, nil)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("listing groups: %w", err)
+			}
+
+			objects := make([]listedObject, 0, len(resp.Groups))
+			for _, g := range resp.Groups {
+				objects = append(objects, listedObject{
+					id:        g.Id,
+					name:      g.Name,
+					tenantIDs: []string{g.TenantId},
+					attributes: map[string]interface{}{
+						"name":      g.Name,
+						"tenant_id": g.TenantId,
+					},
+				})
+			}
+			return objects, nil
+		},
+	})
+}
+
+func dataSourceWebhooks() *schema.Resource {
+	return newListDataSource(listDataSource{
+		attribute: "webhooks",
+		kind:      "Webhook",
+		// Webhooks have no name, only a description.
+		nameAttribute: "description",
+		tenantScoped:  true,
+		elem: map[string]*schema.Schema{
+			"description": computedString("The description of the Webhook."),
+			"url":         computedString("The fully qualified URL of the Webhook's endpoint."),
+			"global":      computedBool("Whether the Webhook is used for every tenant."),
+			"tenant_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The unique Ids of the tenants the Webhook is used for, unless it is global.",
+			},
+		},
+		list: func(ctx context.Context, client Client) ([]listedObject, error) {
+			resp, err := client.FAClient.RetrieveWebhooksWithContext(ctx)
+			if err == nil {
+				err = checkResponse(resp.StatusCode, nil)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("listing webhooks: %w", err)
+			}
+
+			objects := make([]listedObject, 0, len(resp.Webhooks))
+			for _, w := range resp.Webhooks {
+				objects = append(objects, listedObject{
+					id:        w.Id,
+					name:      w.Description,
+					tenantIDs: w.TenantIds,
+					global:    w.Global,
+					attributes: map[string]interface{}{
+						"description": w.Description,
+						"url":         w.Url,
+						"global":      w.Global,
+						"tenant_ids":  w.TenantIds,
+					},
+				})
+			}
+			return objects, nil
+		},
+	})
+}
+
+func dataSourceIDPs() *schema.Resource {
+	types := make([]string, 0, len(identityProviderResourceTypes))
+	for t := range identityProviderResourceTypes {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	return newListDataSource(listDataSource{
+		attribute: "idps",
+		kind:      "identity provider",
+		typed:     true,
+		types:     types,
+		elem: map[string]*schema.Schema{
+			"name":    computedString("The name of the identity provider."),
+			"type":    computedString("The type of the identity provider."),
+			"enabled": computedBool("Whether the identity provider is enabled."),
+		},
+		list: func(ctx context.Context, client Client) ([]listedObject, error) {
+			body, err := readIdentityProviders(ctx, client)
+			if err != nil {
+				return nil, fmt.Errorf("listing identity providers: %w", err)
+			}
+
+			var resp struct {
+				IdentityProviders []struct {
+					ID      string `json:"id"`
+					Name    string `json:"name"`
+					Type    string `json:"type"`
+					Enabled bool   `json:"enabled"`
+				} `json:"identityProviders"`
+			}
+			if err := json.Unmarshal(body, &resp); err != nil {
+				return nil, fmt.Errorf("listing identity providers: %w", err)
+			}
+
+			objects := make([]listedObject, 0, len(resp.IdentityProviders))
+			for _, idp := range resp.IdentityProviders {
+				objects = append(objects, listedObject{
+					id:   idp.ID,
+					name: idp.Name,
+					typ:  idp.Type,
+					attributes: map[string]interface{}{
+						"name":    idp.Name,
+						"type":    idp.Type,
+						"enabled": idp.Enabled,
+					},
+				})
+			}
+			return objects, nil
+		},
+	})
+}
+
+func dataSourceThemes() *schema.Resource {
+	return newListDataSource(listDataSource{
+		attribute: "themes",
+		kind:      "Theme",
+		elem: map[string]*schema.Schema{
+			"name": computedString("The name of the Theme."),
+		},
+		list: func(ctx context.Context, client Client) ([]listedObject, error) {
+			resp, err := client.FAClient.RetrieveThemesWithContext(ctx)
+			if err == nil {
+				err = checkResponse(resp.StatusCode, nil)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("listing themes: %w", err)
+			}
+
+			objects := make([]listedObject, 0, len(resp.Themes))
+			for _, t := range resp.Themes {
+				objects = append(objects, listedObject{
+					id:         t.Id,
+					name:       t.Name,
+					attributes: map[string]interface{}{"name": t.Name},
+				})
+			}
+			return objects, nil
+		},
+	})
+}
+
+func dataSourceEmailTemplates() *schema.Resource {
+	return newListDataSource(listDataSource{
+		attribute: "email_templates",
+		kind:      "Email Template",
+		elem: map[string]*schema.Schema{
+			"name":              computedString("The name of the Email Template."),
+			"default_subject":   computedString("The default subject of the email."),
+			"default_from_name": computedString("The default From Name used when sending the email."),
+			"from_email":        computedString("The email address the email is sent from."),
+		},
+		list: func(ctx context.Context, client Client) ([]listedObject, error) {
+			resp, err := client.FAClient.RetrieveEmailTemplatesWithContext(ctx)
+			if err == nil {
+				err = checkResponse(resp.StatusCode, nil)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("listing email templates: %w", err)
+			}
+
+			objects := make([]listedObject, 0, len(resp.EmailTemplates))
+			for _, e := range resp.EmailTemplates {
+				objects = append(objects, listedObject{
+					id:   e.Id,
+					name: e.Name,
+					attributes: map[string]interface{}{
+						"name":              e.Name,
+						"default_subject":   e.DefaultSubject,
+						"default_from_name": e.DefaultFromName,
+						"from_email":        e.FromEmail,
+					},
+				})
+			}
+			return objects, nil
+		},
+	})
+}
@@ -0,0 +1,180 @@
+package fusionauth
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Test_listDataSources(t *testing.T) {
+	const otherTenantID = "6f1b6f5e-58a4-4c1f-a5f1-3c4c79f5a1f2"
+
+	fake, client := newFakeFusionAuthClient(t)
+	ctx := context.Background()
+
+	fake.seed("tenant", map[string]interface{}{"id": otherTenantID, "name": "Other", "issuer": "other.example.com"})
+	fake.seed("application", map[string]interface{}{"id": "2a5c0b65-5bba-4a0b-8c4b-0d3f2f7e9d11", "name": "Pied Piper", "tenantId": fakeDefaultTenantID, "active": true})
+	fake.seed("application", map[string]interface{}{"id": "0e6f1b8c-9a55-4e59-b2a2-0b7a1f8c3d22", "name": "Hooli", "tenantId": otherTenantID, "active": true})
+	fake.seed("lambda", map[string]interface{}{"id": "5b0c7c52-2d24-4a59-9a3b-2b9f8b2c4e33", "name": "Populate", "type": "JWTPopulate"})
+	fake.seed("lambda", map[string]interface{}{"id": "8d6e3a1f-6c3b-4f0e-8f5d-4a2b9c1d7e44", "name": "Reconcile", "type": "GoogleReconcile"})
+	fake.seed("key", map[string]interface{}{"id": "1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e55", "name": "Signing", "type": "RSA", "algorithm": "RS256", "kid": "abc"})
+	fake.seed("key", map[string]interface{}{"id": "9f8e7d6c-5b4a-4392-8170-6f5e4d3c2b66", "name": "Secret", "type": "HMAC", "algorithm": "HS256", "kid": "def"})
+	fake.seed("group", map[string]interface{}{"id": "3b4c5d6e-7f80-4912-a3b4-c5d6e7f80977", "name": "Admins", "tenantId": fakeDefaultTenantID})
+	fake.seed("webhook", map[string]interface{}{"id": "4c5d6e7f-8091-4a23-b4c5-d6e7f8091a88", "description": "Audit", "url": "https://audit.example.com", "global": true})
+	fake.seed("webhook", map[string]interface{}{"id": "5d6e7f80-91a2-4b34-85d6-e7f8091a2b99", "description": "Other", "url": "https://other.example.com", "tenantIds": []interface{}{otherTenantID}})
+	fake.seed("identityProvider", map[string]interface{}{"id": "6e7f8091-a2b3-4c45-96e7-f8091a2b3caa", "name": "Google", "type": "Google", "enabled": true})
+	fake.seed("emailTemplate", map[string]interface{}{"id": "7f8091a2-b3c4-4d56-a7f8-091a2b3c4dbb", "name": "Welcome", "defaultSubject": "Welcome!"})
+
+	tests := []struct {
+		name       string
+		dataSource *schema.Resource
+		attribute  string
+		config     map[string]interface{}
+		wantIDs    []interface{}
+		wantFirst  map[string]interface{}
+	}{
+		{
+			name:       "tenants",
+			dataSource: dataSourceTenants(),
+			attribute:  "tenants",
+			config:     map[string]interface{}{},
+			wantIDs:    []interface{}{fakeDefaultTenantID, otherTenantID},
+			wantFirst:  map[string]interface{}{"name": "Default", "theme_id": fakeDefaultThemeID},
+		},
+		{
+			name:       "applications by tenant",
+			dataSource: dataSourceApplications(),
+			attribute:  "applications",
+			config:     map[string]interface{}{"tenant_id": fakeDefaultTenantID},
+			wantIDs:    []interface{}{fakeDefaultApplicationID, "2a5c0b65-5bba-4a0b-8c4b-0d3f2f7e9d11"},
+			wantFirst:  map[string]interface{}{"name": "FusionAuth", "tenant_id": fakeDefaultTenantID},
+		},
+		{
+			name:       "applications by name regex",
+			dataSource: dataSourceApplications(),
+			attribute:  "applications",
+			config:     map[string]interface{}{"name_regex": "^H"},
+			wantIDs:    []interface{}{"0e6f1b8c-9a55-4e59-b2a2-0b7a1f8c3d22"},
+			wantFirst:  map[string]interface{}{"name": "Hooli", "tenant_id": otherTenantID, "active": true},
+		},
+		{
+			name:       "lambdas by type",
+			dataSource: dataSourceLambdas(),
+			attribute:  "lambdas",
+			config:     map[string]interface{}{"type": "GoogleReconcile"},
+			wantIDs:    []interface{}{"8d6e3a1f-6c3b-4f0e-8f5d-4a2b9c1d7e44"},
+			wantFirst:  map[string]interface{}{"name": "Reconcile", "type": "GoogleReconcile"},
+		},
+		{
+			name:       "keys by type",
+			dataSource: dataSourceKeys(),
+			attribute:  "keys",
+			config:     map[string]interface{}{"type": "RSA"},
+			wantIDs:    []interface{}{"1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e55"},
+			wantFirst:  map[string]interface{}{"name": "Signing", "algorithm": "RS256", "kid": "abc"},
+		},
+		{
+			name:       "groups",
+			dataSource: dataSourceGroups(),
+			attribute:  "groups",
+			config:     map[string]interface{}{"tenant_id": otherTenantID},
+			wantIDs:    []interface{}{},
+		},
+		{
+			name:       "webhooks by tenant include global webhooks",
+			dataSource: dataSourceWebhooks(),
+			attribute:  "webhooks",
+			config:     map[string]interface{}{"tenant_id": fakeDefaultTenantID},
+			wantIDs:    []interface{}{"4c5d6e7f-8091-4a23-b4c5-d6e7f8091a88"},
+			wantFirst:  map[string]interface{}{"description": "Audit", "global": true},
+		},
+		{
+			name:       "webhooks by description",
+			dataSource: dataSourceWebhooks(),
+			attribute:  "webhooks",
+			config:     map[string]interface{}{"description_regex": "Oth"},
+			wantIDs:    []interface{}{"5d6e7f80-91a2-4b34-85d6-e7f8091a2b99"},
+			wantFirst:  map[string]interface{}{"url": "https://other.example.com", "global": false},
+		},
+		{
+			name:       "idps",
+			dataSource: dataSourceIDPs(),
+			attribute:  "idps",
+			config:     map[string]interface{}{"type": "Google"},
+			wantIDs:    []interface{}{"6e7f8091-a2b3-4c45-96e7-f8091a2b3caa"},
+			wantFirst:  map[string]interface{}{"name": "Google", "enabled": true},
+		},
+		{
+			name:       "themes",
+			dataSource: dataSourceThemes(),
+			attribute:  "themes",
+			config:     map[string]interface{}{},
+			wantIDs:    []interface{}{fakeDefaultThemeID},
+			wantFirst:  map[string]interface{}{"name": "FusionAuth"},
+		},
+		{
+			name:       "email templates",
+			dataSource: dataSourceEmailTemplates(),
+			attribute:  "email_templates",
+			config:     map[string]interface{}{"name_regex": "come$"},
+			wantIDs:    []interface{}{"7f8091a2-b3c4-4d56-a7f8-091a2b3c4dbb"},
+			wantFirst:  map[string]interface{}{"default_subject": "Welcome!"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := schema.TestResourceDataRaw(t, tt.dataSource.Schema, tt.config)
+			if diags := tt.dataSource.ReadContext(ctx, data, client); diags.HasError() {
+				t.Fatalf("ReadContext: %v", diags)
+			}
+
+			if got := data.Get("ids"); !reflect.DeepEqual(got, tt.wantIDs) {
+				t.Errorf("ids = %v, want %v", got, tt.wantIDs)
+			}
+			objects := data.Get(tt.attribute).([]interface{})
+			if len(objects) != len(tt.wantIDs) {
+				t.Fatalf("%s has %d objects, want %d", tt.attribute, len(objects), len(tt.wantIDs))
+			}
+			if len(objects) == 0 {
+				return
+			}
+			first := objects[0].(map[string]interface{})
+			if first["id"] != tt.wantIDs[0] {
+				t.Errorf("%s.0.id = %v, want %v", tt.attribute, first["id"], tt.wantIDs[0])
+			}
+			for k, want := range tt.wantFirst {
+				if first[k] != want {
+					t.Errorf("%s.0.%s = %v, want %v", tt.attribute, k, first[k], want)
+				}
+			}
+		})
+	}
+}
+
+func Test_listDataSources_providerTenant(t *testing.T) {
+	const otherTenantID = "6f1b6f5e-58a4-4c1f-a5f1-3c4c79f5a1f2"
+
+	fake, client := newFakeFusionAuthClient(t)
+	client.FAClient.TenantId = fakeDefaultTenantID
+
+	fake.seed("tenant", map[string]interface{}{"id": otherTenantID, "name": "Other"})
+	fake.seed("application", map[string]interface{}{"id": "0e6f1b8c-9a55-4e59-b2a2-0b7a1f8c3d22", "name": "Hooli", "tenantId": otherTenantID})
+	fake.seed("group", map[string]interface{}{"id": "3b4c5d6e-7f80-4912-a3b4-c5d6e7f80977", "name": "Admins", "tenantId": otherTenantID})
+
+	for name, dataSource := range map[string]*schema.Resource{
+		"applications": dataSourceApplications(),
+		"groups":       dataSourceGroups(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			data := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"tenant_id": otherTenantID})
+			if diags := dataSource.ReadContext(context.Background(), data, client); diags.HasError() {
+				t.Fatalf("ReadContext: %v", diags)
+			}
+			if got := data.Get("ids").([]interface{}); len(got) != 1 {
+				t.Errorf("ids = %v, want the %s of the other tenant", got, name)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package fusionauth
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceServerInfo() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServerInfoRead,
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the FusionAuth server, for example 1.68.0.",
+			},
+			"major_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The major component of the FusionAuth version.",
+			},
+			"minor_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The minor component of the FusionAuth version.",
+			},
+			"patch_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The patch component of the FusionAuth version.",
+			},
+		},
+	}
+}
+
+func dataSourceServerInfoRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	version := client.ServerVersion
+	if version == "" {
+		resp, faErrs, err := client.FAClient.RetrieveVersionWithContext(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := checkResponse(resp.StatusCode, faErrs); err != nil {
+			return diag.FromErr(err)
+		}
+		version = resp.Version
+	}
+
+	parts := parseVersion(version)
+
+	data.SetId(version)
+	return setResourceData("server_info", data, map[string]interface{}{
+		"version":       version,
+		"major_version": parts[0],
+		"minor_version": parts[1],
+		"patch_version": parts[2],
+	})
+}
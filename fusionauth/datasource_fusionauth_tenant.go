@@ -2,47 +2,76 @@ package fusionauth
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceTenant() *schema.Resource {
+	// The tenant's attributes are those of the fusionauth_tenant resource,
+	// so they are read with the resource's mapping.
+	s := dataSourceSchemaFromResourceSchema(newTenant().Schema, "source_tenant_id", "webhook_ids")
+	s["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"name", "tenant_id"},
+		Description:  "The name of the Tenant.",
+	}
+	s["tenant_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"name", "tenant_id"},
+		Description:  "The unique Id of the Tenant.",
+		ValidateFunc: validation.IsUUID,
+	}
+
 	return &schema.Resource{
 		ReadContext: dataSourceTenantRead,
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The name of the Tenant.",
-			},
-		},
+		Schema:      s,
 	}
 }
 
-func dataSourceTenantRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func dataSourceTenantRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	resp, err := client.FAClient.RetrieveTenants()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	if err := checkResponse(resp.StatusCode, nil); err != nil {
-		return diag.FromErr(err)
-	}
-	name := data.Get("name").(string)
 	var t *fusionauth.Tenant
+	if id, ok := data.GetOk("tenant_id"); ok {
+		resp, faErrs, err := client.FAClient.RetrieveTenantWithContext(ctx, id.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			return diag.Errorf("couldn't find tenant %s", id)
+		}
+		if err := checkResponse(resp.StatusCode, faErrs); err != nil {
+			return diag.FromErr(err)
+		}
+		t = &resp.Tenant
+	} else {
+		resp, err := client.FAClient.RetrieveTenantsWithContext(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := checkResponse(resp.StatusCode, nil); err != nil {
+			return diag.FromErr(err)
+		}
+		name := data.Get("name").(string)
 
-	for i := range resp.Tenants {
-		if resp.Tenants[i].Name == name {
-			t = &resp.Tenants[i]
+		for i := range resp.Tenants {
+			if resp.Tenants[i].Name == name {
+				t = &resp.Tenants[i]
+			}
+		}
+		if t == nil {
+			return diag.Errorf("couldn't find tenant %s", name)
 		}
 	}
-	if t == nil {
-		return diag.Errorf("couldn't find tenant %s", name)
-	}
+
 	data.SetId(t.Id)
-	return nil
+	return buildResourceDataFromTenant(*t, data)
 }
@@ -0,0 +1,86 @@
+package fusionauth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Test_dataSourceTenant_schema(t *testing.T) {
+	s := dataSourceTenant().Schema
+
+	for _, k := range []string{"source_tenant_id", "webhook_ids"} {
+		if _, ok := s[k]; ok {
+			t.Errorf("%s is exposed by the data source", k)
+		}
+	}
+
+	email := s["email_configuration"].Elem.(*schema.Resource).Schema
+	for _, k := range []string{"password_wo", "password_wo_version"} {
+		if _, ok := email[k]; ok {
+			t.Errorf("email_configuration.%s is exposed by the data source", k)
+		}
+	}
+	if !email["password"].Computed || !email["password"].Sensitive || email["password"].Optional {
+		t.Errorf("email_configuration.password = %+v, want a sensitive computed attribute", email["password"])
+	}
+	if s["issuer"] == nil || !s["issuer"].Computed || s["issuer"].Optional {
+		t.Errorf("issuer = %+v, want a computed attribute", s["issuer"])
+	}
+}
+
+func Test_dataSourceTenantRead(t *testing.T) {
+	fake, client := newFakeFusionAuthClient(t)
+	ctx := context.Background()
+
+	fake.seed("tenant", map[string]interface{}{
+		"id":      fakeDefaultTenantID,
+		"name":    "Default",
+		"issuer":  "https://auth.example.com",
+		"themeId": fakeDefaultThemeID,
+		"emailConfiguration": map[string]interface{}{
+			"host":     "smtp.example.com",
+			"port":     587,
+			"password": "smtp-password",
+		},
+	})
+
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+	}{
+		{name: "by name", config: map[string]interface{}{"name": "Default"}},
+		{name: "by id", config: map[string]interface{}{"tenant_id": fakeDefaultTenantID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := schema.TestResourceDataRaw(t, dataSourceTenant().Schema, tt.config)
+			if diags := dataSourceTenantRead(ctx, data, client); diags.HasError() {
+				t.Fatalf("dataSourceTenantRead: %v", diags)
+			}
+
+			for k, want := range map[string]interface{}{
+				"tenant_id":                      fakeDefaultTenantID,
+				"name":                           "Default",
+				"issuer":                         "https://auth.example.com",
+				"theme_id":                       fakeDefaultThemeID,
+				"email_configuration.0.host":     "smtp.example.com",
+				"email_configuration.0.port":     587,
+				"email_configuration.0.password": "smtp-password",
+			} {
+				if got := data.Get(k); got != want {
+					t.Errorf("%s = %v, want %v", k, got, want)
+				}
+			}
+			if data.Id() != fakeDefaultTenantID {
+				t.Errorf("Id = %s, want %s", data.Id(), fakeDefaultTenantID)
+			}
+		})
+	}
+
+	data := schema.TestResourceDataRaw(t, dataSourceTenant().Schema, map[string]interface{}{"name": "Missing"})
+	if diags := dataSourceTenantRead(ctx, data, client); !diags.HasError() {
+		t.Error("reading a missing tenant succeeded")
+	}
+}
@@ -334,7 +334,7 @@ func dataSourceTheme() *schema.Resource {
 	}
 }
 
-func dataSourceThemeRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func dataSourceThemeRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
 	var searchTerm string
@@ -342,7 +342,7 @@ func dataSourceThemeRead(_ context.Context, data *schema.ResourceData, i interfa
 
 	if entityID, ok := data.GetOk("theme_id"); ok {
 		searchTerm = entityID.(string)
-		res, err, _ := client.FAClient.RetrieveTheme(searchTerm)
+		res, err, _ := client.FAClient.RetrieveThemeWithContext(ctx, searchTerm)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -364,7 +364,7 @@ func dataSourceThemeRead(_ context.Context, data *schema.ResourceData, i interfa
 				},
 			},
 		}
-		res, err, _ := client.FAClient.SearchThemes(searchRequest)
+		res, err, _ := client.FAClient.SearchThemesWithContext(ctx, searchRequest)
 		if err != nil {
 			return diag.FromErr(err)
 		}
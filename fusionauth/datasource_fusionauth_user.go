@@ -219,14 +219,8 @@ func dataSourceUser() *schema.Resource {
 }
 
 //nolint:gocyclo,gocognit
-func dataSourceUserRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-
-	oldTenantID := client.FAClient.TenantId
-	client.FAClient.TenantId = data.Get("tenant_id").(string)
-	defer func() {
-		client.FAClient.TenantId = oldTenantID
-	}()
+func dataSourceUserRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := tenantScopedClient(i.(Client), data)
 
 	var searchID string
 	var resp *fusionauth.UserResponse
@@ -236,16 +230,16 @@ func dataSourceUserRead(_ context.Context, data *schema.ResourceData, i interfac
 	// Either `user_id` or `username` are guaranteed to be set
 	if userID, ok := data.GetOk("user_id"); ok {
 		searchID = userID.(string)
-		resp, faErrs, err = client.FAClient.RetrieveUser(searchID)
+		resp, faErrs, err = client.FAClient.RetrieveUserWithContext(ctx, searchID)
 	} else if username, ok := data.GetOk("username"); ok {
 		searchID = username.(string)
-		resp, faErrs, err = client.FAClient.RetrieveUserByUsername(searchID)
+		resp, faErrs, err = client.FAClient.RetrieveUserByUsernameWithContext(ctx, searchID)
 	} else if email, ok := data.GetOk("email"); ok {
 		searchID = email.(string)
-		resp, faErrs, err = client.FAClient.RetrieveUserByEmail(searchID)
+		resp, faErrs, err = client.FAClient.RetrieveUserByEmailWithContext(ctx, searchID)
 	} else if phoneNumber, ok := data.GetOk("phone_number"); ok {
 		searchID = phoneNumber.(string)
-		resp, faErrs, err = client.FAClient.RetrieveUserByLoginId(searchID)
+		resp, faErrs, err = client.FAClient.RetrieveUserByLoginIdWithContext(ctx, searchID)
 	} else {
 		return diag.Errorf("user_id, username, email or phone_number must be set")
 	}
@@ -37,7 +37,7 @@ func dataSourceUserGroupMembership() *schema.Resource {
 	}
 }
 
-func dataSourceUserGroupMembershipRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func dataSourceUserGroupMembershipRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
 	gmsreq := fusionauth.GroupMemberSearchRequest{
@@ -47,7 +47,7 @@ func dataSourceUserGroupMembershipRead(_ context.Context, data *schema.ResourceD
 		},
 	}
 
-	resp, faErrs, err := client.FAClient.SearchGroupMembers(gmsreq)
+	resp, faErrs, err := client.FAClient.SearchGroupMembersWithContext(ctx, gmsreq)
 	if err != nil {
 		return diag.Errorf("RetrieveUserGroupMembership err: %v", err)
 	}
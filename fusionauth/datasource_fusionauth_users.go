@@ -0,0 +1,281 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUsersRead,
+		Schema: map[string]*schema.Schema{
+			// Data Source Parameters
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The unique Id of the tenant used to scope the search. Defaults to the provider's `tenant_id`. Users of every tenant are only searched when neither is set.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"query_string": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"query"},
+				Description:   "The query string to search for, in the syntax of the search engine FusionAuth is configured with, e.g. `email:*@example.com` with Elasticsearch. Every user is returned when neither this nor `query` is set.",
+			},
+			"query": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"query_string"},
+				Description:   "A JSON Elasticsearch query to search for. Only supported when FusionAuth is configured to use Elasticsearch.",
+				ValidateFunc:  validation.StringIsJSON,
+			},
+			"sort_field": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The fields to sort the users by, in order of precedence.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the field to sort by, e.g. `email` or `insertInstant`.",
+						},
+						"order": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(fusionauth.Sort_Asc),
+							Description:  "The order to sort in, `asc` or `desc`.",
+							ValidateFunc: validation.StringInSlice([]string{string(fusionauth.Sort_Asc), string(fusionauth.Sort_Desc)}, false),
+						},
+						"missing": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Where users without the field are sorted, `_first` or `_last`, or the value used for them.",
+						},
+					},
+				},
+			},
+			"start_row": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "The offset of the first user to return, for paging through the results.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"page_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				Description:  "The number of users requested from FusionAuth at a time.",
+				ValidateFunc: validation.IntBetween(1, 10000),
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				Description:  "The maximum number of users to return.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			// Data Source Attributes
+			"total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of users matching the search, which may be more than were returned.",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The Ids of the returned users.",
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The returned users, in the order FusionAuth returned them.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique Id of the User.",
+						},
+						"tenant_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique Id of the tenant the User belongs to.",
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The User’s email address.",
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The username of the User.",
+						},
+						"active": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if the User is active. False if the User has been deactivated.",
+						},
+						"registrations": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The User’s registrations to applications.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique Id of the registration.",
+									},
+									"application_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique Id of the application the User is registered to.",
+									},
+									"roles": {
+										Type:        schema.TypeSet,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "The roles the User has in the application.",
+									},
+									"username": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The username of the User for the application.",
+									},
+									"verified": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the registration has been verified.",
+									},
+								},
+							},
+						},
+						"memberships": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The User’s group memberships.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique Id of the membership.",
+									},
+									"group_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique Id of the group.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUsersRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := tenantScopedClient(i.(Client), data)
+
+	criteria := fusionauth.UserSearchCriteria{}
+	criteria.Query = data.Get("query").(string)
+	criteria.QueryString = data.Get("query_string").(string)
+	if criteria.Query == "" && criteria.QueryString == "" {
+		criteria.QueryString = "*"
+	}
+	for _, f := range data.Get("sort_field").([]interface{}) {
+		field := f.(map[string]interface{})
+		criteria.SortFields = append(criteria.SortFields, fusionauth.SortField{
+			Name:    field["name"].(string),
+			Order:   fusionauth.Sort(field["order"].(string)),
+			Missing: field["missing"].(string),
+		})
+	}
+
+	pageSize := data.Get("page_size").(int)
+	maxResults := data.Get("max_results").(int)
+	criteria.StartRow = data.Get("start_row").(int)
+
+	var users []fusionauth.User
+	var total int64
+	for len(users) < maxResults {
+		criteria.NumberOfResults = min(pageSize, maxResults-len(users))
+
+		resp, faErrs, err := client.FAClient.SearchUsersByQueryWithContext(ctx, fusionauth.SearchRequest{
+			// Registrations and memberships are only returned when asked for.
+			ExpandableRequest: fusionauth.ExpandableRequest{Expand: []string{"registrations", "memberships"}},
+			Search:            criteria,
+		})
+		if err == nil {
+			err = checkResponse(resp.StatusCode, faErrs)
+		}
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("searching users: %w", err))
+		}
+
+		users = append(users, resp.Users...)
+		total = resp.Total
+		criteria.StartRow += len(resp.Users)
+		if len(resp.Users) == 0 || int64(criteria.StartRow) >= total {
+			break
+		}
+	}
+
+	ids := make([]string, 0, len(users))
+	values := make([]map[string]interface{}, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.Id)
+
+		registrations := make([]map[string]interface{}, 0, len(u.Registrations))
+		for _, r := range u.Registrations {
+			registrations = append(registrations, map[string]interface{}{
+				"id":             r.Id,
+				"application_id": r.ApplicationId,
+				"roles":          r.Roles,
+				"username":       r.Username,
+				"verified":       r.Verified,
+			})
+		}
+		memberships := make([]map[string]interface{}, 0, len(u.Memberships))
+		for _, m := range u.Memberships {
+			memberships = append(memberships, map[string]interface{}{
+				"id":       m.Id,
+				"group_id": m.GroupId,
+			})
+		}
+
+		values = append(values, map[string]interface{}{
+			"id":            u.Id,
+			"tenant_id":     u.TenantId,
+			"email":         u.Email,
+			"username":      u.Username,
+			"active":        u.Active,
+			"registrations": registrations,
+			"memberships":   memberships,
+		})
+	}
+
+	data.SetId("users")
+	if err := data.Set("total", total); err != nil {
+		return diag.Errorf("users.total: %s", err.Error())
+	}
+	if err := data.Set("ids", ids); err != nil {
+		return diag.Errorf("users.ids: %s", err.Error())
+	}
+	if err := data.Set("users", values); err != nil {
+		return diag.Errorf("users.users: %s", err.Error())
+	}
+
+	return nil
+}
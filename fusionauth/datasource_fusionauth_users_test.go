@@ -0,0 +1,117 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Test_dataSourceUsersRead(t *testing.T) {
+	const otherTenantID = "6f1b6f5e-58a4-4c1f-a5f1-3c4c79f5a1f2"
+
+	fake, client := newFakeFusionAuthClient(t)
+	ctx := context.Background()
+
+	fake.seed("tenant", map[string]interface{}{"id": otherTenantID, "name": "Other"})
+	for i := 1; i <= 5; i++ {
+		fake.seed("user", map[string]interface{}{
+			"id":       fmt.Sprintf("00000000-0000-4000-8000-00000000000%d", i),
+			"email":    fmt.Sprintf("user%d@example.com", i),
+			"tenantId": fakeDefaultTenantID,
+			"active":   true,
+		})
+	}
+	fake.seed("user", map[string]interface{}{
+		"id":       "00000000-0000-4000-8000-000000000009",
+		"email":    "admin@piedpiper.com",
+		"tenantId": otherTenantID,
+		"registrations": []interface{}{
+			map[string]interface{}{"id": "10000000-0000-4000-8000-000000000001", "applicationId": fakeDefaultApplicationID, "roles": []interface{}{"admin"}},
+		},
+		"memberships": []interface{}{
+			map[string]interface{}{"id": "20000000-0000-4000-8000-000000000001", "groupId": "30000000-0000-4000-8000-000000000001"},
+		},
+	})
+
+	tests := []struct {
+		name      string
+		config    map[string]interface{}
+		wantIDs   []interface{}
+		wantTotal int
+	}{
+		{
+			name:      "every user",
+			config:    map[string]interface{}{},
+			wantIDs:   []interface{}{"00000000-0000-4000-8000-000000000001", "00000000-0000-4000-8000-000000000002", "00000000-0000-4000-8000-000000000003", "00000000-0000-4000-8000-000000000004", "00000000-0000-4000-8000-000000000005", "00000000-0000-4000-8000-000000000009"},
+			wantTotal: 6,
+		},
+		{
+			name:      "by domain",
+			config:    map[string]interface{}{"query_string": "*@piedpiper.com"},
+			wantIDs:   []interface{}{"00000000-0000-4000-8000-000000000009"},
+			wantTotal: 1,
+		},
+		{
+			name:      "by tenant",
+			config:    map[string]interface{}{"tenant_id": otherTenantID},
+			wantIDs:   []interface{}{"00000000-0000-4000-8000-000000000009"},
+			wantTotal: 1,
+		},
+		{
+			name:      "paged and capped",
+			config:    map[string]interface{}{"query_string": "*@example.com", "page_size": 2, "max_results": 3},
+			wantIDs:   []interface{}{"00000000-0000-4000-8000-000000000001", "00000000-0000-4000-8000-000000000002", "00000000-0000-4000-8000-000000000003"},
+			wantTotal: 5,
+		},
+		{
+			name:      "from a start row",
+			config:    map[string]interface{}{"query_string": "*@example.com", "page_size": 2, "start_row": 3},
+			wantIDs:   []interface{}{"00000000-0000-4000-8000-000000000004", "00000000-0000-4000-8000-000000000005"},
+			wantTotal: 5,
+		},
+		{
+			name: "sorted",
+			config: map[string]interface{}{
+				"query_string": "*@example.com",
+				"max_results":  2,
+				"sort_field":   []interface{}{map[string]interface{}{"name": "email", "order": "desc"}},
+			},
+			wantIDs:   []interface{}{"00000000-0000-4000-8000-000000000005", "00000000-0000-4000-8000-000000000004"},
+			wantTotal: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := schema.TestResourceDataRaw(t, dataSourceUsers().Schema, tt.config)
+			if diags := dataSourceUsersRead(ctx, data, client); diags.HasError() {
+				t.Fatalf("dataSourceUsersRead: %v", diags)
+			}
+
+			if got := data.Get("ids"); !reflect.DeepEqual(got, tt.wantIDs) {
+				t.Errorf("ids = %v, want %v", got, tt.wantIDs)
+			}
+			if got := data.Get("total"); got != tt.wantTotal {
+				t.Errorf("total = %v, want %d", got, tt.wantTotal)
+			}
+		})
+	}
+
+	data := schema.TestResourceDataRaw(t, dataSourceUsers().Schema, map[string]interface{}{"query_string": "admin@*"})
+	if diags := dataSourceUsersRead(ctx, data, client); diags.HasError() {
+		t.Fatalf("dataSourceUsersRead: %v", diags)
+	}
+	for k, want := range map[string]interface{}{
+		"users.0.email":                          "admin@piedpiper.com",
+		"users.0.tenant_id":                      otherTenantID,
+		"users.0.registrations.0.application_id": fakeDefaultApplicationID,
+		"users.0.registrations.0.roles.#":        1,
+		"users.0.memberships.0.group_id":         "30000000-0000-4000-8000-000000000001",
+	} {
+		if got := data.Get(k); got != want {
+			t.Errorf("%s = %v, want %v", k, got, want)
+		}
+	}
+}
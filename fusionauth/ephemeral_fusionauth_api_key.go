@@ -0,0 +1,260 @@
+package fusionauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ephemeralAPIKeyPrivateKey and ephemeralAPIKeyTenantPrivateKey are the
+// private data keys the Id and the tenant of the API key are kept under, so it
+// can be deleted when the ephemeral resource is closed.
+const (
+	ephemeralAPIKeyPrivateKey       = "api_key_id"
+	ephemeralAPIKeyTenantPrivateKey = "api_key_tenant_id"
+)
+
+// ephemeralAPIKey creates an API key that only exists for the duration of a
+// Terraform run, and is never stored in plan or state.
+type ephemeralAPIKey struct {
+	sdkProvider *sdkschema.Provider
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure = &ephemeralAPIKey{}
+	_ ephemeral.EphemeralResourceWithClose     = &ephemeralAPIKey{}
+)
+
+func newEphemeralAPIKey() ephemeral.EphemeralResource {
+	return &ephemeralAPIKey{}
+}
+
+type ephemeralAPIKeyModel struct {
+	ID                    types.String                     `tfsdk:"id"`
+	Key                   types.String                     `tfsdk:"key"`
+	TenantID              types.String                     `tfsdk:"tenant_id"`
+	Name                  types.String                     `tfsdk:"name"`
+	Description           types.String                     `tfsdk:"description"`
+	IPAccessControlListID types.String                     `tfsdk:"ip_access_control_list_id"`
+	ExpirationInstant     types.Int64                      `tfsdk:"expiration_instant"`
+	PermissionsEndpoints  []ephemeralAPIKeyPermissionModel `tfsdk:"permissions_endpoints"`
+}
+
+type ephemeralAPIKeyPermissionModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	Delete   types.Bool   `tfsdk:"delete"`
+	Get      types.Bool   `tfsdk:"get"`
+	Patch    types.Bool   `tfsdk:"patch"`
+	Post     types.Bool   `tfsdk:"post"`
+	Put      types.Bool   `tfsdk:"put"`
+}
+
+func (r *ephemeralAPIKey) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *ephemeralAPIKey) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates an API key for the duration of a Terraform run. The key is deleted when the run ends, and is never stored in plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Id of the API key.",
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key.",
+			},
+			"tenant_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique Id of the Tenant. This value is required if the key is meant to be tenant scoped. Tenant scoped keys can only be used to access users and other tenant scoped objects for the specified tenant.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the API key, which must be unique. Defaults to a generated name, so concurrent runs don't conflict.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description of the key.",
+			},
+			"ip_access_control_list_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Id of the IP Access Control List limiting access to this API key.",
+			},
+			"expiration_instant": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The expiration instant of this API key, in case the run ends without the key being deleted.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"permissions_endpoints": schema.SetNestedBlock{
+				Description: "Endpoint permissions for this key. Without any, the key is a super key that authorizes the key for all the endpoints.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint": schema.StringAttribute{
+							Required:    true,
+							Description: "The endpoint, e.g. /api/user.",
+						},
+						"delete": schema.BoolAttribute{
+							Optional:    true,
+							Description: "HTTP DELETE Verb",
+						},
+						"get": schema.BoolAttribute{
+							Optional:    true,
+							Description: "HTTP GET Verb",
+						},
+						"patch": schema.BoolAttribute{
+							Optional:    true,
+							Description: "HTTP PATCH Verb",
+						},
+						"post": schema.BoolAttribute{
+							Optional:    true,
+							Description: "HTTP POST Verb",
+						},
+						"put": schema.BoolAttribute{
+							Optional:    true,
+							Description: "HTTP PUT Verb",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ephemeralAPIKey) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.sdkProvider = configureEphemeralResource(req, resp)
+}
+
+func (r *ephemeralAPIKey) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralAPIKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := ephemeralClient(r.sdkProvider)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Name.ValueString() == "" {
+		// The key isn't retrievable, which FusionAuth requires a name for.
+		suffix, err := uuid.GenerateUUID()
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to generate API key name", err.Error())
+			return
+		}
+		data.Name = types.StringValue("terraform-ephemeral-" + suffix)
+	}
+
+	ak := buildEphemeralAPIKey(data)
+	client = client.withTenantID(ak.TenantId)
+	ak.TenantId = client.FAClient.TenantId
+
+	res, faErrs, err := createManualAPIKey(ctx, client.FAClient, "", manualAPIKeyRequest{
+		ApiKey: convertToManualAPIKey(ak),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create API key", err.Error())
+		return
+	}
+	if err := checkResponse(res.StatusCode, faErrs); err != nil {
+		resp.Diagnostics.AddError("Unable to create API key", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(res.ApiKey.Id)
+	data.Key = types.StringValue(res.ApiKey.Key)
+	data.TenantID = types.StringValue(res.ApiKey.TenantId)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+
+	id, _ := json.Marshal(res.ApiKey.Id)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, ephemeralAPIKeyPrivateKey, id)...)
+	tenantID, _ := json.Marshal(res.ApiKey.TenantId)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, ephemeralAPIKeyTenantPrivateKey, tenantID)...)
+}
+
+func (r *ephemeralAPIKey) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	b, diags := req.Private.GetKey(ctx, ephemeralAPIKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || b == nil {
+		return
+	}
+
+	var id string
+	if err := json.Unmarshal(b, &id); err != nil {
+		resp.Diagnostics.AddError("Unable to read the API key Id", err.Error())
+		return
+	}
+
+	var tenantID string
+	b, diags = req.Private.GetKey(ctx, ephemeralAPIKeyTenantPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if b != nil {
+		if err := json.Unmarshal(b, &tenantID); err != nil {
+			resp.Diagnostics.AddError("Unable to read the API key tenant Id", err.Error())
+			return
+		}
+	}
+
+	client, diags := ephemeralClient(r.sdkProvider)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A tenant scoped key can't be deleted through another tenant.
+	client = client.withTenantID(tenantID)
+
+	res, faErrs, err := client.FAClient.DeleteAPIKeyWithContext(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete API key", err.Error())
+		return
+	}
+	if res.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err := checkResponse(res.StatusCode, faErrs); err != nil {
+		resp.Diagnostics.AddError("Unable to delete API key", err.Error())
+	}
+}
+
+func buildEphemeralAPIKey(data ephemeralAPIKeyModel) fusionauth.APIKey {
+	ak := fusionauth.APIKey{
+		TenantId:              data.TenantID.ValueString(),
+		IpAccessControlListId: data.IPAccessControlListID.ValueString(),
+		MetaData: fusionauth.APIKeyMetaData{
+			Attributes: map[string]string{
+				"description": data.Description.ValueString(),
+			},
+		},
+		ExpirationInstant: data.ExpirationInstant.ValueInt64(),
+		Name:              data.Name.ValueString(),
+	}
+
+	if len(data.PermissionsEndpoints) > 0 {
+		ak.Permissions.Endpoints = make(map[string][]string, len(data.PermissionsEndpoints))
+		for _, p := range data.PermissionsEndpoints {
+			ak.Permissions.Endpoints[p.Endpoint.ValueString()] = apiKeyMethods(
+				p.Delete.ValueBool(), p.Get.ValueBool(), p.Patch.ValueBool(), p.Post.ValueBool(), p.Put.ValueBool(),
+			)
+		}
+	}
+
+	return ak
+}
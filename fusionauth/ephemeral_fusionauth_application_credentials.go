@@ -0,0 +1,93 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ephemeralApplicationCredentials reads the OAuth client credentials of an
+// application, without storing them in plan or state.
+type ephemeralApplicationCredentials struct {
+	sdkProvider *sdkschema.Provider
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralApplicationCredentials{}
+
+func newEphemeralApplicationCredentials() ephemeral.EphemeralResource {
+	return &ephemeralApplicationCredentials{}
+}
+
+type ephemeralApplicationCredentialsModel struct {
+	ApplicationID types.String `tfsdk:"application_id"`
+	ClientID      types.String `tfsdk:"client_id"`
+	ClientSecret  types.String `tfsdk:"client_secret"`
+}
+
+func (r *ephemeralApplicationCredentials) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_credentials"
+}
+
+func (r *ephemeralApplicationCredentials) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the OAuth client credentials of an Application for the duration of a Terraform run, without storing them in plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The Id of the Application.",
+			},
+			"client_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The OAuth client Id of the Application.",
+			},
+			"client_secret": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The OAuth client secret of the Application.",
+			},
+		},
+	}
+}
+
+func (r *ephemeralApplicationCredentials) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.sdkProvider = configureEphemeralResource(req, resp)
+}
+
+func (r *ephemeralApplicationCredentials) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralApplicationCredentialsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := ephemeralClient(r.sdkProvider)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := client.FAClient.RetrieveApplicationWithContext(ctx, data.ApplicationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve application", err.Error())
+		return
+	}
+	if res.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddAttributeError(path.Root("application_id"), "Application not found",
+			fmt.Sprintf("No application with Id %s exists.", data.ApplicationID.ValueString()))
+		return
+	}
+	if err := checkResponse(res.StatusCode, nil); err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve application", err.Error())
+		return
+	}
+
+	data.ClientID = types.StringValue(res.Application.OauthConfiguration.ClientId)
+	data.ClientSecret = types.StringValue(res.Application.OauthConfiguration.ClientSecret)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,674 @@
+package fusionauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// exportObject is an object in FusionAuth that is exported as a resource.
+type exportObject struct {
+	ResourceType string
+	ID           string
+	Name         string
+	// Address is the resource name the object is exported as, unique within
+	// its resource type.
+	Address string
+	// NoImport is set for objects whose id in FusionAuth isn't known, which
+	// can't have an import block.
+	NoImport bool
+	// Sparse is set for objects that only hold the fields that were set,
+	// e.g. by a kickstart request. Only the attributes those fields map to
+	// are written, whatever their value, so Terraform applies the same
+	// defaults to the rest as it would when they are left out.
+	Sparse bool
+	// ImportID is the id the import block uses, when the resource's importer
+	// expects more than the object's id, e.g. "<application_id>/<role_id>".
+	ImportID string
+	// Attributes are set before the object is read, for resources that can
+	// only be read through their parent, e.g. an application role's
+	// application_id.
+	Attributes map[string]interface{}
+}
+
+// exportVariable is a variable declared for a required sensitive attribute,
+// which isn't exported but must be set for the configuration to be valid.
+type exportVariable struct {
+	Name string
+	// Type is the variable's type constraint, e.g. "string".
+	Type string
+}
+
+// exportListers enumerate the objects of each supported resource type. They
+// are listed in dependency order, so resources are written after the
+// resources they reference.
+var exportListers = []importLister{
+	listExportKeys,
+	listExportIPAccessControlLists,
+	listExportLambdas,
+	exportLister("fusionauth_generic_messenger", listImportMessengers(fusionauth.MessengerType_Generic)),
+	exportLister("fusionauth_twilio_messenger", listImportMessengers(fusionauth.MessengerType_Twilio)),
+	exportLister("fusionauth_generic_connector", listImportConnectors(fusionauth.ConnectorType_Generic)),
+	exportLister("fusionauth_ldap_connector", listImportConnectors(fusionauth.ConnectorType_LDAP)),
+	exportLister("fusionauth_email", listImportEmailTemplates),
+	listExportThemes,
+	exportLister("fusionauth_consent", listImportConsents),
+	exportLister("fusionauth_user_action", listImportUserActions),
+	exportLister("fusionauth_form_field", listImportFormFields),
+	exportLister("fusionauth_form", listImportForms),
+	listExportTenants,
+	listExportWebhooks,
+	exportLister("fusionauth_entity_type", listImportEntityTypes),
+	listExportApplications,
+	listExportApplicationRoles,
+	exportLister("fusionauth_group", listImportGroups),
+	listExportIdentityProviders,
+	listExportReactor,
+}
+
+// identityProviderResourceTypes maps FusionAuth identity provider types to the
+// resource type that manages them.
+var identityProviderResourceTypes = map[string]string{
+	"Apple":              "fusionauth_idp_apple",
+	"EpicGames":          "fusionauth_idp_epic_games",
+	"ExternalJWT":        "fusionauth_idp_external_jwt",
+	"Facebook":           "fusionauth_idp_facebook",
+	"Google":             "fusionauth_idp_google",
+	"HYPR":               "fusionauth_idp_hypr",
+	"LinkedIn":           "fusionauth_idp_linkedin",
+	"Nintendo":           "fusionauth_idp_nintendo",
+	"OpenIDConnect":      "fusionauth_idp_open_id_connect",
+	"SAMLv2":             "fusionauth_idp_saml_v2",
+	"SAMLv2IdPInitiated": "fusionauth_idp_saml_v2_idp_initated",
+	"SonyPSN":            "fusionauth_idp_sony_psn",
+	"Steam":              "fusionauth_idp_steam",
+	"Twitch":             "fusionauth_idp_twitch",
+	"Twitter":            "fusionauth_idp_twitter",
+	"Xbox":               "fusionauth_idp_xbox",
+}
+
+// Export writes an import block and a matching resource block for every
+// object of the resource types in exportListers, e.g. every tenant,
+// application and identity provider in FusionAuth.
+// The provider is configured from its environment variables, e.g. FA_DOMAIN
+// and FA_API_KEY. References between the exported objects are written as
+// references to the resource addresses rather than as raw ids.
+func Export(ctx context.Context, w io.Writer) error {
+	p := Provider()
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(nil)); diags.HasError() {
+		return diagsError(diags)
+	}
+
+	return exportResources(ctx, p, p.Meta().(Client), w)
+}
+
+func exportResources(ctx context.Context, p *schema.Provider, client Client, w io.Writer) error {
+	var objects []exportObject
+	for _, list := range exportListers {
+		listed, err := list(ctx, client)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, listed...)
+	}
+
+	return writeExportedResources(ctx, p, client, nil, objects, w)
+}
+
+// writeExportedResources reads every object through its resource and writes
+// the resource block for it, preceded by an import block unless NoImport is
+// set, and by the variables its required sensitive attributes reference.
+// Sparse objects are read again through baseline, which serves them with
+// every field that was set changed, so the attributes that differ between
+// the two reads are the ones that were set.
+func writeExportedResources(ctx context.Context, p *schema.Provider, client Client, baseline *Client, objects []exportObject, w io.Writer) error {
+	// Assign every object its address first, so references can be resolved
+	// regardless of the order resources are written in.
+	used := map[string]bool{}
+	refs := map[string]hcl.Traversal{}
+	for i := range objects {
+		obj := &objects[i]
+		obj.Address = exportAddress(obj.Name, obj.ResourceType, used)
+		if _, ok := refs[obj.ID]; !ok {
+			refs[obj.ID] = hcl.Traversal{
+				hcl.TraverseRoot{Name: obj.ResourceType},
+				hcl.TraverseAttr{Name: obj.Address},
+				hcl.TraverseAttr{Name: "id"},
+			}
+		}
+	}
+
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+	for _, obj := range objects {
+		res := p.ResourcesMap[obj.ResourceType]
+		values, err := readExportObject(ctx, res, client, obj)
+		if err != nil {
+			return err
+		}
+		if values == nil {
+			// Deleted since it was listed.
+			continue
+		}
+
+		bw := &exportBodyWriter{
+			selfID:         obj.ID,
+			refs:           refs,
+			sparse:         obj.Sparse,
+			variablePrefix: strings.TrimPrefix(obj.ResourceType, "fusionauth_") + "_" + obj.Address + "_",
+		}
+		var baselineValues map[string]interface{}
+		if obj.Sparse && baseline != nil {
+			if baselineValues, err = readExportObject(ctx, res, *baseline, obj); err != nil {
+				return err
+			}
+		}
+
+		block := hclwrite.NewBlock("resource", []string{obj.ResourceType, obj.Address})
+		bw.write(block.Body(), res.Schema, values, baselineValues, "")
+		sensitive, variables := bw.sensitive, bw.variables
+
+		if !obj.NoImport {
+			imp := body.AppendNewBlock("import", nil).Body()
+			imp.SetAttributeTraversal("to", hcl.Traversal{
+				hcl.TraverseRoot{Name: obj.ResourceType},
+				hcl.TraverseAttr{Name: obj.Address},
+			})
+			importID := obj.ImportID
+			if importID == "" {
+				importID = obj.ID
+			}
+			imp.SetAttributeValue("id", cty.StringVal(importID))
+			body.AppendNewline()
+		}
+
+		for _, v := range variables {
+			variable := body.AppendNewBlock("variable", []string{v.Name}).Body()
+			variable.SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: v.Type}})
+			variable.SetAttributeValue("sensitive", cty.True)
+			body.AppendNewline()
+		}
+
+		if len(sensitive) > 0 {
+			body.AppendUnstructuredTokens(hclwrite.Tokens{{
+				Type:  hclsyntax.TokenComment,
+				Bytes: []byte(fmt.Sprintf("# Sensitive attributes are not exported: %s\n", strings.Join(sensitive, ", "))),
+			}})
+		}
+		body.AppendBlock(block)
+		body.AppendNewline()
+	}
+
+	_, err := w.Write(f.Bytes())
+	return err
+}
+
+// readExportObject reads an object through its resource, and returns the
+// values of its attributes, or nil when the object doesn't exist.
+func readExportObject(ctx context.Context, res *schema.Resource, client Client, obj exportObject) (map[string]interface{}, error) {
+	data := res.Data(nil)
+	data.SetId(obj.ID)
+	for k, v := range obj.Attributes {
+		if err := data.Set(k, v); err != nil {
+			return nil, fmt.Errorf("reading %s %s: %w", obj.ResourceType, obj.ID, err)
+		}
+	}
+	if diags := res.ReadContext(ctx, data, client); diags.HasError() {
+		return nil, fmt.Errorf("reading %s %s: %w", obj.ResourceType, obj.ID, diagsError(diags))
+	}
+	if data.Id() == "" {
+		return nil, nil
+	}
+
+	values := make(map[string]interface{}, len(res.Schema))
+	for k := range res.Schema {
+		values[k] = data.Get(k)
+	}
+	return values, nil
+}
+
+// exportBodyWriter writes the attributes and nested blocks of an object's
+// resource block. Computed only and deprecated attributes, and optional
+// attributes left at their default, are omitted. Sensitive attributes are
+// omitted too, and their paths are collected so they can be called out,
+// except for required ones, which reference a variable named after
+// variablePrefix and their path instead. String attributes named *_id or
+// *_ids referencing another exported object are written as references.
+type exportBodyWriter struct {
+	selfID         string
+	refs           map[string]hcl.Traversal
+	sparse         bool
+	variablePrefix string
+
+	sensitive []string
+	variables []exportVariable
+}
+
+// write writes values to body. For sparse objects, baseline holds the values
+// read with every field that was set changed, if they could be paired with
+// values. It reports whether anything was written other than required
+// attributes left blank, which is all a block the object doesn't have
+// would hold.
+func (bw *exportBodyWriter) write(body *hclwrite.Body, schemaMap map[string]*schema.Schema, values, baseline map[string]interface{}, path string) (hasContent bool) {
+	keys := make([]string, 0, len(schemaMap))
+	for k := range schemaMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		s := schemaMap[k]
+		v := values[k]
+		if (s.Computed && !s.Optional) || (s.Deprecated != "" && !s.Required) || !bw.wanted(s, v, baseline, k) {
+			continue
+		}
+		if s.Sensitive {
+			if variableType, ok := exportVariableTypes[s.Type]; ok && s.Required {
+				name := bw.variablePrefix + strings.ReplaceAll(path, ".", "_") + k
+				body.SetAttributeTraversal(k, hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: name}})
+				bw.variables = append(bw.variables, exportVariable{Name: name, Type: variableType})
+				hasContent = true
+				continue
+			}
+			bw.sensitive = append(bw.sensitive, path+k)
+			continue
+		}
+
+		if nested, ok := s.Elem.(*schema.Resource); ok {
+			// The items of a set are ordered by their hash, which changes
+			// with their values, so only list items can be paired with the
+			// baseline.
+			var baselineItems []interface{}
+			if s.Type == schema.TypeList {
+				baselineItems = exportList(baseline[k])
+			}
+			for i, item := range exportList(v) {
+				m, _ := item.(map[string]interface{})
+				var baselineItem map[string]interface{}
+				if i < len(baselineItems) {
+					baselineItem, _ = baselineItems[i].(map[string]interface{})
+				}
+				block := hclwrite.NewBlock(k, nil)
+				if !bw.write(block.Body(), nested.Schema, m, baselineItem, fmt.Sprintf("%s%s.%d.", path, k, i)) && !s.Required && s.MinItems == 0 {
+					continue
+				}
+				body.AppendBlock(block)
+				hasContent = true
+			}
+			continue
+		}
+
+		if id, ok := v.(string); ok && id == bw.selfID {
+			// The object's own id, which the import block already provides.
+			continue
+		}
+
+		isReference := strings.HasSuffix(k, "_id") || strings.HasSuffix(k, "_ids")
+		body.SetAttributeRaw(k, exportTokens(v, isReference, bw.refs))
+		if !s.Required || exportSet(v) {
+			hasContent = true
+		}
+	}
+
+	return hasContent
+}
+
+// wanted reports whether a value should be written, which it should when it's
+// required or differs from what leaving it out would result in. For sparse
+// objects that is when a field that was set maps to it, i.e. when it differs
+// from its baseline, or, without a baseline, when it isn't a zero value.
+func (bw *exportBodyWriter) wanted(s *schema.Schema, v interface{}, baseline map[string]interface{}, k string) bool {
+	switch {
+	case s.Required:
+		return true
+	case bw.sparse && baseline != nil:
+		return fmt.Sprint(v) != fmt.Sprint(baseline[k])
+	case bw.sparse || s.Default == nil:
+		return exportSet(v)
+	default:
+		return fmt.Sprint(v) != fmt.Sprint(s.Default)
+	}
+}
+
+// exportVariableTypes maps the types of attributes that can reference a
+// variable to the variable's type constraint.
+var exportVariableTypes = map[schema.ValueType]string{
+	schema.TypeString: "string",
+	schema.TypeInt:    "number",
+	schema.TypeFloat:  "number",
+	schema.TypeBool:   "bool",
+}
+
+// exportSet reports whether a value isn't a zero value.
+func exportSet(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case int:
+		return v != 0
+	case float64:
+		return v != 0
+	case bool:
+		return v
+	case map[string]interface{}:
+		return len(v) > 0
+	default:
+		return len(exportList(v)) > 0
+	}
+}
+
+// exportTokens returns the HCL tokens for a value. When isReference is set,
+// strings that are the id of an exported object become references to it.
+func exportTokens(v interface{}, isReference bool, refs map[string]hcl.Traversal) hclwrite.Tokens {
+	switch v := v.(type) {
+	case string:
+		if ref, ok := refs[v]; ok && isReference {
+			return hclwrite.TokensForTraversal(ref)
+		}
+		return hclwrite.TokensForValue(cty.StringVal(v))
+	case int:
+		return hclwrite.TokensForValue(cty.NumberIntVal(int64(v)))
+	case float64:
+		return hclwrite.TokensForValue(cty.NumberFloatVal(v))
+	case bool:
+		return hclwrite.TokensForValue(cty.BoolVal(v))
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		items := make([]hclwrite.ObjectAttrTokens, 0, len(v))
+		for _, k := range keys {
+			items = append(items, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(k)),
+				Value: exportTokens(v[k], false, refs),
+			})
+		}
+		return hclwrite.TokensForObject(items)
+	default:
+		list := exportList(v)
+		items := make([]hclwrite.Tokens, 0, len(list))
+		for _, item := range list {
+			items = append(items, exportTokens(item, isReference, refs))
+		}
+		return hclwrite.TokensForTuple(items)
+	}
+}
+
+// exportList returns the elements of a list or set value.
+func exportList(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	default:
+		return nil
+	}
+}
+
+// exportAddress turns an object's name into a unique resource name, e.g.
+// "My Tenant" becomes "my_tenant", or "my_tenant_2" if that name is taken.
+func exportAddress(name, resourceType string, used map[string]bool) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+			underscore = false
+			continue
+		}
+		if !underscore && b.Len() > 0 {
+			b.WriteRune('_')
+			underscore = true
+		}
+	}
+
+	base := strings.TrimSuffix(b.String(), "_")
+	if base == "" {
+		base = strings.TrimPrefix(resourceType, "fusionauth_")
+	}
+	if unicode.IsDigit(rune(base[0])) {
+		base = "_" + base
+	}
+
+	address := base
+	for n := 2; used[resourceType+"."+address]; n++ {
+		address = fmt.Sprintf("%s_%d", base, n)
+	}
+	used[resourceType+"."+address] = true
+
+	return address
+}
+
+// diagsError flattens error diagnostics into a single error.
+func diagsError(diags diag.Diagnostics) error {
+	var msgs []string
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		msg := d.Summary
+		if d.Detail != "" {
+			msg += ": " + d.Detail
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return fmt.Errorf("%s", strings.Join(msgs, "; "))
+}
+
+func listExportKeys(ctx context.Context, client Client) ([]exportObject, error) {
+	resp, err := client.FAClient.RetrieveKeysWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing keys: %w", err)
+	}
+
+	objects := make([]exportObject, 0, len(resp.Keys))
+	for _, k := range resp.Keys {
+		objects = append(objects, exportObject{ResourceType: "fusionauth_key", ID: k.Id, Name: k.Name})
+	}
+	return objects, nil
+}
+
+func listExportIPAccessControlLists(ctx context.Context, client Client) ([]exportObject, error) {
+	acls, err := searchIPAccessControlLists(ctx, client, "")
+	if err != nil {
+		return nil, fmt.Errorf("listing IP access control lists: %w", err)
+	}
+
+	objects := make([]exportObject, 0, len(acls))
+	for _, acl := range acls {
+		objects = append(objects, exportObject{ResourceType: "fusionauth_ip_access_control_list", ID: acl.Id, Name: acl.Name})
+	}
+	return objects, nil
+}
+
+func listExportLambdas(ctx context.Context, client Client) ([]exportObject, error) {
+	resp, err := client.FAClient.RetrieveLambdasWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing lambdas: %w", err)
+	}
+
+	objects := make([]exportObject, 0, len(resp.Lambdas))
+	for _, l := range resp.Lambdas {
+		objects = append(objects, exportObject{ResourceType: "fusionauth_lambda", ID: l.Id, Name: l.Name})
+	}
+	return objects, nil
+}
+
+func listExportThemes(ctx context.Context, client Client) ([]exportObject, error) {
+	resp, err := client.FAClient.RetrieveThemesWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing themes: %w", err)
+	}
+
+	objects := make([]exportObject, 0, len(resp.Themes))
+	for _, t := range resp.Themes {
+		objects = append(objects, exportObject{ResourceType: "fusionauth_theme", ID: t.Id, Name: t.Name})
+	}
+	return objects, nil
+}
+
+func listExportTenants(ctx context.Context, client Client) ([]exportObject, error) {
+	resp, err := client.FAClient.RetrieveTenantsWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing tenants: %w", err)
+	}
+
+	objects := make([]exportObject, 0, len(resp.Tenants))
+	for _, t := range resp.Tenants {
+		objects = append(objects, exportObject{ResourceType: "fusionauth_tenant", ID: t.Id, Name: t.Name})
+	}
+	return objects, nil
+}
+
+func listExportApplications(ctx context.Context, client Client) ([]exportObject, error) {
+	resp, err := client.FAClient.RetrieveApplicationsWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing applications: %w", err)
+	}
+
+	objects := make([]exportObject, 0, len(resp.Applications))
+	for _, a := range resp.Applications {
+		objects = append(objects, exportObject{ResourceType: "fusionauth_application", ID: a.Id, Name: a.Name})
+	}
+	return objects, nil
+}
+
+func listExportIdentityProviders(ctx context.Context, client Client) ([]exportObject, error) {
+	body, err := readIdentityProviders(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("listing identity providers: %w", err)
+	}
+
+	var resp struct {
+		IdentityProviders []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+			Type string `json:"type"`
+		} `json:"identityProviders"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("listing identity providers: %w", err)
+	}
+
+	objects := make([]exportObject, 0, len(resp.IdentityProviders))
+	for _, idp := range resp.IdentityProviders {
+		resourceType, ok := identityProviderResourceTypes[idp.Type]
+		if !ok {
+			// Not supported by the provider.
+			continue
+		}
+		objects = append(objects, exportObject{ResourceType: resourceType, ID: idp.ID, Name: idp.Name})
+	}
+	return objects, nil
+}
+
+// exportLister returns an importLister that lists the objects of list as
+// resources of resourceType.
+func exportLister(resourceType string, list importLister) importLister {
+	return func(ctx context.Context, client Client) ([]exportObject, error) {
+		objects, err := list(ctx, client)
+		for i := range objects {
+			objects[i].ResourceType = resourceType
+		}
+		return objects, err
+	}
+}
+
+func listExportWebhooks(ctx context.Context, client Client) ([]exportObject, error) {
+	resp, err := client.FAClient.RetrieveWebhooksWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing webhooks: %w", err)
+	}
+
+	objects := make([]exportObject, 0, len(resp.Webhooks))
+	for _, wh := range resp.Webhooks {
+		// Webhooks have no name, so they're named by their description, or
+		// their URL when they have none.
+		name := wh.Description
+		if name == "" {
+			name = wh.Url
+		}
+		objects = append(objects, exportObject{ResourceType: "fusionauth_webhook", ID: wh.Id, Name: name})
+	}
+	return objects, nil
+}
+
+func listExportApplicationRoles(ctx context.Context, client Client) ([]exportObject, error) {
+	resp, err := client.FAClient.RetrieveApplicationsWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing application roles: %w", err)
+	}
+
+	var objects []exportObject
+	for _, a := range resp.Applications {
+		for _, role := range a.Roles {
+			objects = append(objects, exportObject{
+				ResourceType: "fusionauth_application_role",
+				ID:           role.Id,
+				Name:         a.Name + " " + role.Name,
+				ImportID:     a.Id + "/" + role.Id,
+				Attributes:   map[string]interface{}{"application_id": a.Id},
+			})
+		}
+	}
+	return objects, nil
+}
+
+// listExportReactor lists the reactor when it is licensed. The reactor has no
+// id in FusionAuth and can't be imported, so applying the export activates it
+// again with the license id it is given.
+func listExportReactor(ctx context.Context, client Client) ([]exportObject, error) {
+	resp, err := client.FAClient.RetrieveReactorStatusWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing the reactor: %w", err)
+	}
+
+	if !resp.Status.Licensed {
+		return nil, nil
+	}
+	return []exportObject{{ResourceType: "fusionauth_reactor", ID: reactorID, NoImport: true}}, nil
+}
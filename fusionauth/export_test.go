@@ -0,0 +1,156 @@
+package fusionauth
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+func Test_exportAddress(t *testing.T) {
+	used := map[string]bool{}
+	tests := []struct {
+		name, resourceType, want string
+	}{
+		{"Default", "fusionauth_tenant", "default"},
+		{"My Tenant!", "fusionauth_tenant", "my_tenant"},
+		{"my-tenant", "fusionauth_tenant", "my_tenant_2"},
+		{"my-tenant", "fusionauth_theme", "my_tenant"},
+		{"2FA app", "fusionauth_application", "_2fa_app"},
+		{"???", "fusionauth_lambda", "lambda"},
+	}
+
+	for _, tt := range tests {
+		if got := exportAddress(tt.name, tt.resourceType, used); got != tt.want {
+			t.Errorf("exportAddress(%q, %q) = %q, want %q", tt.name, tt.resourceType, got, tt.want)
+		}
+	}
+}
+
+func Test_exportResources(t *testing.T) {
+	fake, client := newFakeFusionAuthClient(t)
+	ctx := context.Background()
+
+	lambda, _, err := client.FAClient.CreateLambdaWithContext(ctx, "", fusionauth.LambdaRequest{Lambda: fusionauth.Lambda{
+		Name: "Populate JWT",
+		Type: fusionauth.LambdaType_JWTPopulate,
+		Body: "function populate(jwt, user, registration) {}",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	app, _, err := client.FAClient.CreateApplicationWithContext(ctx, "", fusionauth.ApplicationRequest{Application: fusionauth.Application{
+		Name:     "Pied Piper",
+		TenantId: fakeDefaultTenantID,
+		LambdaConfiguration: fusionauth.LambdaConfiguration{
+			AccessTokenPopulateId: lambda.Lambda.Id,
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	role, _, err := client.FAClient.CreateApplicationRoleWithContext(ctx, app.Application.Id, "", fusionauth.ApplicationRequest{Role: fusionauth.ApplicationRole{Name: "admin"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const (
+		webhookID     = "0c8f6d36-1d77-4bd3-9a83-6f0ed1c6c6a1"
+		groupID       = "1b7e3c5a-2f4d-4a6b-8c9d-0e1f2a3b4c5d"
+		templateID    = "2c8f4d6b-3a5e-4b7c-9d0e-1f2a3b4c5d6e"
+		consentID     = "3d9a5e7c-4b6f-4c8d-8e1f-2a3b4c5d6e7f"
+		userActionID  = "4eab6f8d-5c7a-4d9e-9f2a-3b4c5d6e7f8a"
+		fieldID       = "5fbc7a9e-6d8b-4e0f-8a3b-4c5d6e7f8a9b"
+		formID        = "6acd8b0f-7e9c-4f1a-9b4c-5d6e7f8a9b0c"
+		entityTypeID  = "7bde9c1a-8f0d-4a2b-8c5d-6e7f8a9b0c1d"
+		messengerID   = "8cef0d2b-9a1e-4b3c-9d6e-7f8a9b0c1d2e"
+		ldapID        = "9df01e3c-0b2f-4c4d-8e7f-8a9b0c1d2e3f"
+		genericConnID = "ae012f4d-1c3a-4d5e-9f8a-9b0c1d2e3f4a"
+	)
+	fake.seed("webhook", map[string]interface{}{"id": webhookID, "url": "https://example.com/hook", "global": true})
+	fake.seed("group", map[string]interface{}{"id": groupID, "name": "Engineering", "tenantId": fakeDefaultTenantID, "roles": map[string]interface{}{app.Application.Id: []interface{}{map[string]interface{}{"id": role.Role.Id, "name": "admin"}}}})
+	fake.seed("emailTemplate", map[string]interface{}{"id": templateID, "name": "Welcome", "defaultSubject": "Welcome", "defaultFromName": "Pied Piper", "defaultHtmlTemplate": "<p>Hi</p>", "defaultTextTemplate": "Hi"})
+	fake.seed("consent", map[string]interface{}{"id": consentID, "name": "COPPA", "consentEmailTemplateId": templateID, "countryMinimumAgeForSelfConsent": map[string]interface{}{}, "defaultMinimumAgeForSelfConsent": 13})
+	fake.seed("userAction", map[string]interface{}{"id": userActionID, "name": "Suspend", "active": true, "temporal": true})
+	fake.seed("field", map[string]interface{}{"id": fieldID, "name": "Nickname", "key": "user.data.nickname", "type": "string", "control": "text"})
+	fake.seed("form", map[string]interface{}{"id": formID, "name": "Signup", "type": "registration", "steps": []interface{}{map[string]interface{}{"fields": []interface{}{fieldID}}}})
+	fake.seed("entityType", map[string]interface{}{"id": entityTypeID, "name": "API client"})
+	fake.seed("messenger", map[string]interface{}{"id": messengerID, "name": "SMS", "type": "Twilio", "url": "https://api.twilio.com", "accountSID": "sid"})
+	fake.seed("connector", map[string]interface{}{"id": ldapID, "name": "Directory", "type": "LDAP", "baseStructure": "dc=example,dc=com", "systemAccountDN": "cn=admin", "loginIdAttribute": "uid", "identifyingAttribute": "uid", "authenticationURL": "ldap://ldap.example.com", "securityMethod": "None", "requestedAttributes": []interface{}{"uid"}})
+	fake.seed("connector", map[string]interface{}{"id": genericConnID, "name": "Legacy", "type": "Generic", "authenticationURL": "https://legacy.example.com/login"})
+	fake.reactorLicenseID = "license"
+
+	var out bytes.Buffer
+	if err := exportResources(ctx, Provider(), client, &out); err != nil {
+		t.Fatalf("exportResources: %s", err)
+	}
+
+	f, diags := hclwrite.ParseConfig(out.Bytes(), "export.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("exported HCL doesn't parse: %s\n%s", diags, out.String())
+	}
+
+	// Every resource type with a lister is exported, and every variable that
+	// is referenced is declared.
+	exportedTypes := map[string]bool{}
+	variables := map[string]bool{}
+	for _, block := range f.Body().Blocks() {
+		switch block.Type() {
+		case "resource":
+			exportedTypes[block.Labels()[0]] = true
+		case "variable":
+			variables[block.Labels()[0]] = true
+		}
+	}
+	for _, resourceType := range []string{
+		"fusionauth_lambda", "fusionauth_twilio_messenger", "fusionauth_generic_connector",
+		"fusionauth_ldap_connector", "fusionauth_email", "fusionauth_theme", "fusionauth_consent",
+		"fusionauth_user_action", "fusionauth_form_field", "fusionauth_form", "fusionauth_tenant",
+		"fusionauth_webhook", "fusionauth_entity_type", "fusionauth_application",
+		"fusionauth_application_role", "fusionauth_group", "fusionauth_reactor",
+	} {
+		if !exportedTypes[resourceType] {
+			t.Errorf("export has no %s:\n%s", resourceType, out.String())
+		}
+	}
+	for _, ref := range regexp.MustCompile(`var\.(\w+)`).FindAllStringSubmatch(out.String(), -1) {
+		if !variables[ref[1]] {
+			t.Errorf("export references the undeclared variable %s:\n%s", ref[1], out.String())
+		}
+	}
+
+	// Collapse the alignment of attributes.
+	exported := strings.Join(strings.Fields(out.String()), " ")
+	for _, want := range []string{
+		`to = fusionauth_lambda.populate_jwt`,
+		`id = "` + lambda.Lambda.Id + `"`,
+		`resource "fusionauth_tenant" "default"`,
+		`theme_id = fusionauth_theme.fusionauth.id`,
+		`resource "fusionauth_application" "pied_piper"`,
+		`tenant_id = fusionauth_tenant.default.id`,
+		`access_token_populate_id = fusionauth_lambda.populate_jwt.id`,
+		`to = fusionauth_application_role.pied_piper_admin`,
+		`id = "` + app.Application.Id + `/` + role.Role.Id + `"`,
+		`application_id = fusionauth_application.pied_piper.id`,
+		`role_ids = [fusionauth_application_role.pied_piper_admin.id]`,
+		`resource "fusionauth_webhook" "https_example_com_hook"`,
+		`consent_email_template_id = fusionauth_email.welcome.id`,
+		`resource "fusionauth_form_field" "nickname"`,
+		`variable "ldap_connector_directory_system_account_password" { type = string sensitive = true }`,
+		`system_account_password = var.ldap_connector_directory_system_account_password`,
+		`license_id = var.reactor_reactor_license_id`,
+	} {
+		if !strings.Contains(exported, want) {
+			t.Errorf("export is missing %q:\n%s", want, out.String())
+		}
+	}
+
+	if strings.Contains(exported, `lambda_id`) {
+		t.Errorf("export contains the lambda's own id attribute:\n%s", out.String())
+	}
+}
@@ -0,0 +1,846 @@
+package fusionauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// fakeFusionAuthAPIKey is the only API key the fake FusionAuth accepts.
+	fakeFusionAuthAPIKey = "fake-fusionauth-api-key"
+	// fakeFusionAuthVersion is the version the fake FusionAuth reports, which
+	// is kept in step with the go-client the provider is built against.
+	fakeFusionAuthVersion = "1.68.0"
+
+	fakeDefaultTenantID      = "ea1b25b2-c0fe-4c52-a5d2-0a4ff8c1b3c6"
+	fakeDefaultApplicationID = "3c219e58-ed0e-4b18-ad48-f4f92793ae32"
+	fakeDefaultThemeID       = "75a068fd-e94b-451a-9aeb-3ddb9a3b5987"
+	fakeDefaultConnectorID   = "e3306678-a53a-4964-9040-1c96f36dda72"
+)
+
+// fakeCollection describes a FusionAuth API that the fake serves as plain CRUD
+// over JSON objects.
+type fakeCollection struct {
+	// uri is the API's base path, e.g. "/api/tenant".
+	uri string
+	// singular and plural are the names objects are wrapped in, in requests
+	// and responses, e.g. {"tenant": {...}} and {"tenants": [...]}.
+	singular string
+	plural   string
+	// required lists the fields that must be set on create and update.
+	required []string
+	// requiredOneOf lists fields of which at least one must be set.
+	requiredOneOf []string
+	// sourceField is the request field naming an existing object to copy
+	// the new object from, e.g. "sourceTenantId".
+	sourceField string
+	// tenantScoped objects belong to a tenant, which defaults to the tenant
+	// the request is scoped to, and aren't visible to other tenants.
+	tenantScoped bool
+	// writeOnly fields are accepted but never returned, e.g. a password.
+	writeOnly []string
+}
+
+var fakeCollections = []fakeCollection{
+	{uri: "/api/tenant", singular: "tenant", plural: "tenants", required: []string{"name"}, sourceField: "sourceTenantId"},
+	{uri: "/api/application", singular: "application", plural: "applications", required: []string{"name"}, tenantScoped: true},
+	{uri: "/api/key", singular: "key", plural: "keys", required: []string{"name"}},
+	{uri: "/api/lambda", singular: "lambda", plural: "lambdas", required: []string{"name", "type"}},
+	{uri: "/api/user", singular: "user", plural: "users", requiredOneOf: []string{"email", "username"}, tenantScoped: true, writeOnly: []string{"password"}},
+	{uri: "/api/group", singular: "group", plural: "groups", required: []string{"name"}, tenantScoped: true},
+	{uri: "/api/identity-provider", singular: "identityProvider", plural: "identityProviders", required: []string{"type"}},
+	{uri: "/api/webhook", singular: "webhook", plural: "webhooks", required: []string{"url"}},
+	{uri: "/api/theme", singular: "theme", plural: "themes", required: []string{"name"}, sourceField: "sourceThemeId"},
+	{uri: "/api/connector", singular: "connector", plural: "connectors", required: []string{"name", "type"}},
+	{uri: "/api/api-key", singular: "apiKey", plural: "apiKeys"},
+	{uri: "/api/ip-acl", singular: "ipAccessControlList", plural: "ipAccessControlLists", required: []string{"name", "entries"}},
+	// Entity types are listed before entities, which are served by a prefix of their path.
+	{uri: "/api/entity/type", singular: "entityType", plural: "entityTypes", required: []string{"name"}},
+	{uri: "/api/entity", singular: "entity", plural: "entities", required: []string{"name"}, tenantScoped: true},
+	{uri: "/api/email/template", singular: "emailTemplate", plural: "emailTemplates", required: []string{"name"}},
+	{uri: "/api/consent", singular: "consent", plural: "consents", required: []string{"name"}},
+	// Form fields are listed before forms, which are served by a prefix of their path.
+	{uri: "/api/form/field", singular: "field", plural: "fields", required: []string{"name"}},
+	{uri: "/api/form", singular: "form", plural: "forms", required: []string{"name"}},
+	{uri: "/api/user-action", singular: "userAction", plural: "userActions", required: []string{"name"}},
+	{uri: "/api/messenger", singular: "messenger", plural: "messengers", required: []string{"name", "type"}},
+	{uri: "/api/message/template", singular: "messageTemplate", plural: "messageTemplates", required: []string{"name", "type"}},
+}
+
+// fakeFusionAuth is an in-memory fake of the FusionAuth REST API, so resource
+// code paths can be tested without a running FusionAuth. It answers with the
+// same status codes and error bodies FusionAuth does: 401 without the API
+// key, 404 with an empty body for unknown objects and 400 with field errors
+// for invalid requests. It doesn't apply any of FusionAuth's defaults, objects
+// are returned exactly as they were stored.
+type fakeFusionAuth struct {
+	apiKey string
+
+	mu      sync.Mutex
+	objects map[string]map[string]map[string]interface{}
+
+	// asyncDeletes is the number of times a deleted tenant or application
+	// is still retrieved, in the PendingDelete state, before it is gone, like
+	// FusionAuth's asynchronous deletes.
+	asyncDeletes   int
+	pendingDeletes map[string]int
+
+	// reactorLicenseID is the license id the reactor was activated with.
+	reactorLicenseID string
+}
+
+// newFakeFusionAuth returns a fake FusionAuth seeded with the default tenant,
+// the FusionAuth application, the default theme and the FusionAuth connector.
+func newFakeFusionAuth(apiKey string) *fakeFusionAuth {
+	f := &fakeFusionAuth{
+		apiKey:         apiKey,
+		objects:        map[string]map[string]map[string]interface{}{},
+		pendingDeletes: map[string]int{},
+	}
+	for _, c := range fakeCollections {
+		f.objects[c.singular] = map[string]map[string]interface{}{}
+	}
+
+	f.seed("tenant", map[string]interface{}{"id": fakeDefaultTenantID, "name": "Default", "themeId": fakeDefaultThemeID})
+	f.seed("application", map[string]interface{}{"id": fakeDefaultApplicationID, "name": "FusionAuth", "tenantId": fakeDefaultTenantID, "roles": []interface{}{}})
+	f.seed("theme", map[string]interface{}{"id": fakeDefaultThemeID, "name": "FusionAuth"})
+	f.seed("connector", map[string]interface{}{"id": fakeDefaultConnectorID, "name": "Default", "type": "FusionAuth"})
+
+	return f
+}
+
+// newFakeFusionAuthClient starts a fake FusionAuth for the duration of the
+// test and returns a provider client pointed at it.
+func newFakeFusionAuthClient(t *testing.T) (*fakeFusionAuth, Client) {
+	t.Helper()
+
+	fake := newFakeFusionAuth(fakeFusionAuthAPIKey)
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	hostURL, _ := url.Parse(srv.URL)
+	return fake, Client{
+		Host:          srv.URL,
+		APIKey:        fakeFusionAuthAPIKey,
+		FAClient:      *fusionauth.NewClient(srv.Client(), hostURL, fakeFusionAuthAPIKey),
+		ServerVersion: fakeFusionAuthVersion,
+	}
+}
+
+func (f *fakeFusionAuth) seed(kind string, obj map[string]interface{}) {
+	now := time.Now().UnixMilli()
+	obj["insertInstant"] = now
+	obj["lastUpdateInstant"] = now
+	f.objects[kind][obj["id"].(string)] = obj
+}
+
+// object returns a copy of a stored object, for assertions in tests.
+func (f *fakeFusionAuth) object(kind, id string) (map[string]interface{}, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	obj, ok := f.objects[kind][id]
+	if !ok {
+		return nil, false
+	}
+	return deepCopyJSON(obj).(map[string]interface{}), true
+}
+
+func (f *fakeFusionAuth) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != f.apiKey {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/api/status":
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{"status": "ok"})
+		return
+	case "/api/system/version":
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{"version": fakeFusionAuthVersion})
+		return
+	case "/api/reactor":
+		f.serveReactor(w, r)
+		return
+	}
+
+	c, segments := routeFakeCollection(r.URL.Path)
+	if c == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case c.singular == "key" && len(segments) > 0 && (segments[0] == "generate" || segments[0] == "import"):
+		if r.Method != http.MethodPost || len(segments) > 2 {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		f.create(w, r, c, strings.Join(segments[1:], ""))
+		return
+	case c.singular == "application" && len(segments) > 1 && segments[1] == "role":
+		f.serveApplicationRole(w, r, segments[0], strings.Join(segments[2:], "/"))
+		return
+	case (c.singular == "ipAccessControlList" || c.singular == "entity") && len(segments) == 1 && segments[0] == "search" && r.Method == http.MethodPost:
+		f.search(w, r, c)
+		return
+	case c.singular == "user" && len(segments) == 1 && segments[0] == "search" && r.Method == http.MethodPost:
+		f.searchUsers(w, r, c)
+		return
+	case len(segments) > 1:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	id := strings.Join(segments, "")
+	switch {
+	case r.Method == http.MethodPost:
+		f.create(w, r, c, id)
+	case r.Method == http.MethodGet && id == "":
+		f.list(w, r, c)
+	case r.Method == http.MethodGet:
+		f.retrieve(w, r, c, id)
+	case r.Method == http.MethodPut, r.Method == http.MethodPatch:
+		f.update(w, r, c, id)
+	case r.Method == http.MethodDelete:
+		f.delete(w, r, c, id)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// serveReactor serves the reactor API, which activates the reactor with a
+// license id, reports whether it is licensed and deactivates it.
+func (f *fakeFusionAuth) serveReactor(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{"status": map[string]interface{}{"licensed": f.reactorLicenseID != ""}})
+	case http.MethodPost:
+		var req struct {
+			LicenseID string `json:"licenseId"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if req.LicenseID == "" {
+			writeFakeFieldError(w, "licenseId", "[blank]licenseId", "You must specify the [licenseId] property.")
+			return
+		}
+		f.reactorLicenseID = req.LicenseID
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		f.reactorLicenseID = ""
+		w.WriteHeader(http.StatusOK)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// routeFakeCollection finds the collection serving path, and splits the rest
+// of the path into its segments.
+func routeFakeCollection(path string) (*fakeCollection, []string) {
+	for i := range fakeCollections {
+		c := &fakeCollections[i]
+		if path != c.uri && !strings.HasPrefix(path, c.uri+"/") {
+			continue
+		}
+
+		rest := strings.Trim(strings.TrimPrefix(path, c.uri), "/")
+		if rest == "" {
+			return c, nil
+		}
+		return c, strings.Split(rest, "/")
+	}
+
+	return nil, nil
+}
+
+func (f *fakeFusionAuth) create(w http.ResponseWriter, r *http.Request, c *fakeCollection, id string) {
+	req, obj, ok := decodeFakeRequest(w, r, c.singular)
+	if !ok {
+		return
+	}
+
+	if id == "" {
+		id, _ = obj["id"].(string)
+	}
+	if id == "" {
+		id, _ = uuid.GenerateUUID()
+	}
+	if _, exists := f.objects[c.singular][id]; exists {
+		writeFakeFieldError(w, c.singular+".id", "[duplicate]"+c.singular+".id",
+			fmt.Sprintf("A %s with Id [%s] already exists.", c.singular, id))
+		return
+	}
+
+	if c.sourceField != "" {
+		if sourceID, _ := req[c.sourceField].(string); sourceID != "" {
+			source, exists := f.objects[c.singular][sourceID]
+			if !exists {
+				writeFakeFieldError(w, c.sourceField, "[invalid]"+c.sourceField,
+					fmt.Sprintf("The [%s] property does not reference an existing %s.", c.sourceField, c.singular))
+				return
+			}
+			obj = mergeJSON(deepCopyJSON(source).(map[string]interface{}), obj)
+		}
+	}
+
+	if c.tenantScoped {
+		if tenantID, _ := obj["tenantId"].(string); tenantID == "" {
+			obj["tenantId"] = requestTenantID(r)
+		}
+		if _, exists := f.objects["tenant"][obj["tenantId"].(string)]; !exists {
+			writeFakeFieldError(w, c.singular+".tenantId", "[invalid]"+c.singular+".tenantId",
+				fmt.Sprintf("The [%s.tenantId] property does not reference an existing tenant.", c.singular))
+			return
+		}
+	}
+
+	if !validateFakeObject(w, c, obj) {
+		return
+	}
+
+	if c.singular == "key" && obj["kid"] == nil {
+		obj["kid"] = strings.ReplaceAll(id, "-", "")[:12]
+	}
+	if c.singular == "apiKey" && obj["key"] == nil {
+		obj["key"], _ = uuid.GenerateUUID()
+	}
+
+	now := time.Now().UnixMilli()
+	obj["id"] = id
+	obj["insertInstant"] = now
+	obj["lastUpdateInstant"] = now
+	f.store(c, obj)
+
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{c.singular: obj})
+}
+
+func (f *fakeFusionAuth) list(w http.ResponseWriter, r *http.Request, c *fakeCollection) {
+	ids := make([]string, 0, len(f.objects[c.singular]))
+	for id, obj := range f.objects[c.singular] {
+		if visibleToRequest(r, c, obj) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	objects := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		objects = append(objects, f.objects[c.singular][id])
+	}
+
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{c.plural: objects})
+}
+
+// search serves the search APIs that match objects by name, which like
+// FusionAuth's match names containing the search string, ignoring case.
+func (f *fakeFusionAuth) search(w http.ResponseWriter, r *http.Request, c *fakeCollection) {
+	var req struct {
+		Search struct {
+			Name            string `json:"name"`
+			NumberOfResults int    `json:"numberOfResults"`
+			StartRow        int    `json:"startRow"`
+		} `json:"search"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ids := make([]string, 0, len(f.objects[c.singular]))
+	for id, obj := range f.objects[c.singular] {
+		name, _ := obj["name"].(string)
+		if visibleToRequest(r, c, obj) && strings.Contains(strings.ToLower(name), strings.ToLower(req.Search.Name)) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	total := len(ids)
+	ids = ids[min(req.Search.StartRow, len(ids)):]
+	if n := req.Search.NumberOfResults; n > 0 && n < len(ids) {
+		ids = ids[:n]
+	}
+
+	objects := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		objects = append(objects, f.objects[c.singular][id])
+	}
+
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{c.plural: objects, "total": total})
+}
+
+// searchUsers serves the user search API. The query string is matched as a
+// glob against the users' emails and usernames, so "*" matches every user,
+// and results are sorted by the top-level fields in sortFields, then by id.
+// Like FusionAuth, registrations and memberships are only returned when they
+// are expanded.
+func (f *fakeFusionAuth) searchUsers(w http.ResponseWriter, r *http.Request, c *fakeCollection) {
+	var req struct {
+		Expand []string `json:"expand"`
+		Search struct {
+			QueryString     string `json:"queryString"`
+			NumberOfResults int    `json:"numberOfResults"`
+			StartRow        int    `json:"startRow"`
+			SortFields      []struct {
+				Name  string `json:"name"`
+				Order string `json:"order"`
+			} `json:"sortFields"`
+		} `json:"search"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var users []map[string]interface{}
+	for _, obj := range f.objects[c.singular] {
+		if !visibleToRequest(r, c, obj) {
+			continue
+		}
+		for _, field := range []string{"email", "username"} {
+			value, _ := obj[field].(string)
+			if matched, _ := path.Match(req.Search.QueryString, value); matched && value != "" {
+				users = append(users, obj)
+				break
+			}
+		}
+	}
+	sort.Slice(users, func(i, j int) bool {
+		for _, field := range req.Search.SortFields {
+			a, b := fmt.Sprint(users[i][field.Name]), fmt.Sprint(users[j][field.Name])
+			if a != b {
+				return (a < b) != (field.Order == "desc")
+			}
+		}
+		return users[i]["id"].(string) < users[j]["id"].(string)
+	})
+
+	total := len(users)
+	users = users[min(req.Search.StartRow, len(users)):]
+	if n := req.Search.NumberOfResults; n > 0 && n < len(users) {
+		users = users[:n]
+	}
+
+	objects := make([]interface{}, 0, len(users))
+	for _, u := range users {
+		u = deepCopyJSON(u).(map[string]interface{})
+		for _, field := range []string{"registrations", "memberships"} {
+			if !slices.Contains(req.Expand, field) {
+				delete(u, field)
+			}
+		}
+		objects = append(objects, u)
+	}
+
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{c.plural: objects, "total": total})
+}
+
+func (f *fakeFusionAuth) retrieve(w http.ResponseWriter, r *http.Request, c *fakeCollection, id string) {
+	if n, pending := f.pendingDeletes[id]; pending {
+		if n == 0 {
+			delete(f.pendingDeletes, id)
+			f.remove(c, id)
+		} else {
+			f.pendingDeletes[id] = n - 1
+		}
+	}
+
+	obj, exists := f.objects[c.singular][id]
+	if !exists || !visibleToRequest(r, c, obj) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{c.singular: obj})
+}
+
+func (f *fakeFusionAuth) update(w http.ResponseWriter, r *http.Request, c *fakeCollection, id string) {
+	existing, exists := f.objects[c.singular][id]
+	if id == "" || !exists || !visibleToRequest(r, c, existing) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	_, obj, ok := decodeFakeRequest(w, r, c.singular)
+	if !ok {
+		return
+	}
+
+	if r.Method == http.MethodPatch {
+		obj = mergeJSON(deepCopyJSON(existing).(map[string]interface{}), obj)
+	}
+	if c.tenantScoped {
+		if tenantID, _ := obj["tenantId"].(string); tenantID == "" {
+			obj["tenantId"] = existing["tenantId"]
+		}
+	}
+
+	if !validateFakeObject(w, c, obj) {
+		return
+	}
+
+	obj["id"] = id
+	obj["insertInstant"] = existing["insertInstant"]
+	obj["lastUpdateInstant"] = time.Now().UnixMilli()
+	f.store(c, obj)
+
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{c.singular: obj})
+}
+
+func (f *fakeFusionAuth) delete(w http.ResponseWriter, r *http.Request, c *fakeCollection, id string) {
+	obj, exists := f.objects[c.singular][id]
+	if id == "" || !exists || !visibleToRequest(r, c, obj) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if f.asyncDeletes > 0 && (c.singular == "tenant" || c.singular == "application") {
+		obj["state"] = "PendingDelete"
+		f.pendingDeletes[id] = f.asyncDeletes
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	f.remove(c, id)
+	w.WriteHeader(http.StatusOK)
+}
+
+// remove removes the object from the store.
+func (f *fakeFusionAuth) remove(c *fakeCollection, id string) {
+	delete(f.objects[c.singular], id)
+
+	// Deleting a tenant deletes everything that belongs to it.
+	if c.singular == "tenant" {
+		for _, other := range fakeCollections {
+			if !other.tenantScoped {
+				continue
+			}
+			for otherID, otherObj := range f.objects[other.singular] {
+				if otherObj["tenantId"] == id {
+					delete(f.objects[other.singular], otherID)
+				}
+			}
+		}
+	}
+}
+
+// serveApplicationRole serves the roles API, which manages the roles nested
+// in an application.
+func (f *fakeFusionAuth) serveApplicationRole(w http.ResponseWriter, r *http.Request, applicationID, roleID string) {
+	app, exists := f.objects["application"][applicationID]
+	if !exists || !visibleToRequest(r, &fakeCollections[1], app) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	roles, _ := app["roles"].([]interface{})
+	index := -1
+	for i, role := range roles {
+		if role.(map[string]interface{})["id"] == roleID {
+			index = i
+		}
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		if index != -1 {
+			writeFakeFieldError(w, "role.id", "[duplicate]role.id", fmt.Sprintf("A role with Id [%s] already exists.", roleID))
+			return
+		}
+	case http.MethodGet, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		if index == -1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{"role": roles[index]})
+		return
+	case http.MethodDelete:
+		app["roles"] = append(roles[:index], roles[index+1:]...)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	_, role, ok := decodeFakeRequest(w, r, "role")
+	if !ok {
+		return
+	}
+	if name, _ := role["name"].(string); name == "" {
+		writeFakeFieldError(w, "role.name", "[blank]role.name", "You must specify the [role.name] property.")
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	role["lastUpdateInstant"] = now
+	if index == -1 {
+		if roleID == "" {
+			roleID, _ = uuid.GenerateUUID()
+		}
+		role["id"] = roleID
+		role["insertInstant"] = now
+		app["roles"] = append(roles, role)
+	} else {
+		if r.Method == http.MethodPatch {
+			role = mergeJSON(deepCopyJSON(roles[index]).(map[string]interface{}), role)
+		}
+		role["id"] = roleID
+		role["insertInstant"] = roles[index].(map[string]interface{})["insertInstant"]
+		roles[index] = role
+	}
+
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{"role": role})
+}
+
+// store saves an object, dropping any write only fields.
+func (f *fakeFusionAuth) store(c *fakeCollection, obj map[string]interface{}) {
+	for _, field := range c.writeOnly {
+		delete(obj, field)
+	}
+	f.objects[c.singular][obj["id"].(string)] = obj
+}
+
+// decodeFakeRequest decodes a request body, returning the whole request and
+// the object wrapped in it under key. Invalid requests are answered with a
+// 400 response.
+func decodeFakeRequest(w http.ResponseWriter, r *http.Request, key string) (req, obj map[string]interface{}, ok bool) {
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeFakeJSON(w, http.StatusBadRequest, fusionauth.Errors{
+			GeneralErrors: []fusionauth.Error{{
+				Code:    "[invalidJSON]",
+				Message: fmt.Sprintf("Unable to parse the request body: %s", err),
+			}},
+		})
+		return nil, nil, false
+	}
+
+	obj, _ = req[key].(map[string]interface{})
+	if obj == nil {
+		writeFakeFieldError(w, key, "[missing]"+key, fmt.Sprintf("Your request is missing the [%s] object.", key))
+		return nil, nil, false
+	}
+
+	return req, obj, true
+}
+
+// validateFakeObject checks an object's required fields, answering with a
+// 400 response listing every missing field.
+func validateFakeObject(w http.ResponseWriter, c *fakeCollection, obj map[string]interface{}) bool {
+	fieldErrors := map[string][]fusionauth.Error{}
+	for _, field := range c.required {
+		if isBlankJSON(obj[field]) {
+			name := c.singular + "." + field
+			fieldErrors[name] = []fusionauth.Error{{
+				Code:    "[blank]" + name,
+				Message: fmt.Sprintf("You must specify the [%s] property.", name),
+			}}
+		}
+	}
+
+	if len(c.requiredOneOf) > 0 {
+		names := make([]string, 0, len(c.requiredOneOf))
+		blank := true
+		for _, field := range c.requiredOneOf {
+			names = append(names, "["+c.singular+"."+field+"]")
+			blank = blank && isBlankJSON(obj[field])
+		}
+		if blank {
+			name := c.singular + "." + c.requiredOneOf[0]
+			fieldErrors[name] = []fusionauth.Error{{
+				Code:    "[blank]" + name,
+				Message: fmt.Sprintf("You must specify either the %s property.", strings.Join(names, " or ")),
+			}}
+		}
+	}
+
+	if len(fieldErrors) == 0 {
+		return true
+	}
+
+	writeFakeJSON(w, http.StatusBadRequest, fusionauth.Errors{FieldErrors: fieldErrors})
+	return false
+}
+
+// visibleToRequest reports whether obj can be seen by a request, which it
+// can't when the request is scoped to a different tenant than the one that
+// owns obj.
+func visibleToRequest(r *http.Request, c *fakeCollection, obj map[string]interface{}) bool {
+	tenantID := r.Header.Get("X-FusionAuth-TenantId")
+	return !c.tenantScoped || tenantID == "" || obj["tenantId"] == tenantID
+}
+
+func requestTenantID(r *http.Request) string {
+	if tenantID := r.Header.Get("X-FusionAuth-TenantId"); tenantID != "" {
+		return tenantID
+	}
+	return fakeDefaultTenantID
+}
+
+func writeFakeFieldError(w http.ResponseWriter, field, code, message string) {
+	writeFakeJSON(w, http.StatusBadRequest, fusionauth.Errors{
+		FieldErrors: map[string][]fusionauth.Error{
+			field: {{Code: code, Message: message}},
+		},
+	})
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func isBlankJSON(v interface{}) bool {
+	s, isString := v.(string)
+	return v == nil || (isString && strings.TrimSpace(s) == "")
+}
+
+func Test_fakeFusionAuth_lambdaLifecycle(t *testing.T) {
+	_, client := newFakeFusionAuthClient(t)
+	ctx := context.Background()
+
+	data := schema.TestResourceDataRaw(t, newLambda().Schema, map[string]interface{}{
+		"name": "populate",
+		"type": string(fusionauth.LambdaType_JWTPopulate),
+		"body": "function populate(jwt, user, registration) {}",
+	})
+	if diags := createLambda(ctx, data, client); diags.HasError() {
+		t.Fatalf("createLambda: %v", diags)
+	}
+
+	if err := data.Set("name", "renamed"); err != nil {
+		t.Fatal(err)
+	}
+	if diags := updateLambda(ctx, data, client); diags.HasError() {
+		t.Fatalf("updateLambda: %v", diags)
+	}
+
+	read := schema.TestResourceDataRaw(t, newLambda().Schema, map[string]interface{}{})
+	read.SetId(data.Id())
+	if diags := readLambda(ctx, read, client); diags.HasError() {
+		t.Fatalf("readLambda: %v", diags)
+	}
+	if got := read.Get("name").(string); got != "renamed" {
+		t.Errorf("name = %q, want %q", got, "renamed")
+	}
+	if got := read.Get("engine_type").(string); got != string(fusionauth.LambdaEngineType_GraalJS) {
+		t.Errorf("engine_type = %q, want %q", got, fusionauth.LambdaEngineType_GraalJS)
+	}
+
+	if diags := deleteLambda(ctx, data, client); diags.HasError() {
+		t.Fatalf("deleteLambda: %v", diags)
+	}
+	if diags := readLambda(ctx, read, client); diags.HasError() || read.Id() != "" {
+		t.Errorf("readLambda after delete: id = %q, diags = %v", read.Id(), diags)
+	}
+	if diags := deleteLambda(ctx, data, client); !diags.HasError() {
+		t.Error("deleting a missing lambda should fail")
+	}
+}
+
+func Test_fakeFusionAuth_validationErrors(t *testing.T) {
+	_, client := newFakeFusionAuthClient(t)
+
+	data := schema.TestResourceDataRaw(t, newApplication().Schema, map[string]interface{}{})
+	diags := createApplication(context.Background(), data, client)
+	if len(diags) != 1 {
+		t.Fatalf("got %d diagnostics, want 1: %v", len(diags), diags)
+	}
+	if want := cty.GetAttrPath("name"); !diags[0].AttributePath.Equals(want) {
+		t.Errorf("AttributePath = %#v, want %#v", diags[0].AttributePath, want)
+	}
+	if diags[0].Summary != "You must specify the [application.name] property." {
+		t.Errorf("Summary = %q", diags[0].Summary)
+	}
+}
+
+func Test_fakeFusionAuth_tenantScoping(t *testing.T) {
+	fake, client := newFakeFusionAuthClient(t)
+	ctx := context.Background()
+
+	tenantID, _ := uuid.GenerateUUID()
+	if _, _, err := client.FAClient.CreateTenantWithContext(ctx, tenantID, fusionauth.TenantRequest{
+		Tenant: fusionauth.Tenant{Name: "other"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	data := schema.TestResourceDataRaw(t, newGroup().Schema, map[string]interface{}{
+		"name":      "admins",
+		"tenant_id": tenantID,
+	})
+	if diags := createGroup(ctx, data, client); diags.HasError() {
+		t.Fatalf("createGroup: %v", diags)
+	}
+	if group, ok := fake.object("group", data.Id()); !ok || group["tenantId"] != tenantID {
+		t.Errorf("stored group = %v, want it in tenant %s", group, tenantID)
+	}
+
+	// The group isn't visible to requests scoped to the default tenant.
+	scoped := client.withTenantID(fakeDefaultTenantID)
+	resp, _, err := scoped.FAClient.RetrieveGroupWithContext(ctx, data.Id())
+	if err != nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("retrieve from another tenant: status = %d, err = %v", resp.StatusCode, err)
+	}
+
+	// Deleting the tenant deletes the group with it.
+	if _, _, err := client.FAClient.DeleteTenantWithContext(ctx, tenantID); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fake.object("group", data.Id()); ok {
+		t.Error("group outlived its tenant")
+	}
+}
+
+func Test_fakeFusionAuth_writeOnlyFields(t *testing.T) {
+	fake, client := newFakeFusionAuthClient(t)
+
+	data := schema.TestResourceDataRaw(t, newUser().Schema, map[string]interface{}{
+		"email":    "user@example.com",
+		"password": "correct horse battery staple",
+	})
+	if diags := createUser(context.Background(), data, client); diags.HasError() {
+		t.Fatalf("createUser: %v", diags)
+	}
+
+	user, ok := fake.object("user", data.Id())
+	if !ok {
+		t.Fatal("user was not stored")
+	}
+	if _, ok := user["password"]; ok {
+		t.Error("the fake stored the user's password")
+	}
+	if user["tenantId"] != fakeDefaultTenantID {
+		t.Errorf("tenantId = %v, want the default tenant", user["tenantId"])
+	}
+}
@@ -3,7 +3,6 @@ package fusionauth
 import (
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"reflect"
 	"strings"
 
@@ -43,10 +42,8 @@ func checkResponse(statusCode int, faErrors *fusionauth.Errors) error {
 	switch {
 	case statusCode >= 200 && statusCode <= 299:
 		return nil
-	case faErrors == nil:
-		return fmt.Errorf("unexpected status code: %d(%s)", statusCode, http.StatusText(statusCode))
 	default:
-		return fmt.Errorf("unexpected status code: %d(%s) Errors: %v", statusCode, http.StatusText(statusCode), faErrors)
+		return &responseError{StatusCode: statusCode, Errors: faErrors}
 	}
 }
 
@@ -85,7 +82,6 @@ func jsonStringToMapStringInterface(in string) (out map[string]interface{}, diag
 	}
 
 	if err := json.Unmarshal([]byte(in), &out); err != nil {
-		fmt.Printf("jsonStringToMapStringInterface: %s", err)
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to transform data to expected type",
@@ -170,22 +166,11 @@ func intMapToStringMap(intMap map[string]interface{}) map[string]string {
 	return m
 }
 
-// clientTenantIDOverride takes in the client and the data. As long as the
-// resource has a tenant_id attribute set, it will override the client's tenant
-// id until the revert function is called.
-func clientTenantIDOverride(client *Client, data *schema.ResourceData) (revert func()) {
-	if tid := data.Get("tenant_id").(string); tid != "" {
-		oldTenantID := client.FAClient.TenantId
-
-		client.FAClient.TenantId = tid
-		return func() {
-			client.FAClient.TenantId = oldTenantID
-		}
-	}
-
-	return func() {
-		// nothing to revert here...
-	}
+// tenantScopedClient takes in the client and the data. As long as the resource
+// has a tenant_id attribute set, the returned copy of the client will send it
+// as the tenant for every request.
+func tenantScopedClient(client Client, data *schema.ResourceData) Client {
+	return client.withTenantID(data.Get("tenant_id").(string))
 }
 
 // getValueAndIsSet function checks if the key exists in the schema resource data.
@@ -0,0 +1,132 @@
+package fusionauth
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// configRule checks a rule FusionAuth enforces across several attributes
+// against the raw configuration. A broken rule is returned as a
+// cty.PathError, which Terraform pins to the offending attribute.
+type configRule func(config cty.Value) error
+
+// validateConfig returns a CustomizeDiffFunc that checks rules at plan time,
+// rather than leaving FusionAuth to reject the request at apply time with a
+// 400. Rules only judge values that are known, so a configuration referring
+// to e.g. a lambda created in the same run isn't rejected. The first broken
+// rule fails the plan.
+func validateConfig(rules ...configRule) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+		config := diff.GetRawConfig()
+		if config.IsNull() || !config.IsKnown() {
+			return nil
+		}
+
+		for _, rule := range rules {
+			if err := rule(config); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// configValue returns the value at path in the raw configuration, where
+// single item blocks are indexed at 0. A null value is returned when any
+// step along the way is missing or null, and an unknown one when any step is
+// unknown.
+func configValue(config cty.Value, path cty.Path) cty.Value {
+	v := config
+	for _, step := range path {
+		if v.IsNull() {
+			return cty.NullVal(cty.DynamicPseudoType)
+		}
+		if !v.IsKnown() {
+			return cty.UnknownVal(cty.DynamicPseudoType)
+		}
+
+		switch step := step.(type) {
+		case cty.GetAttrStep:
+			if !v.Type().IsObjectType() || !v.Type().HasAttribute(step.Name) {
+				return cty.NullVal(cty.DynamicPseudoType)
+			}
+			v = v.GetAttr(step.Name)
+		case cty.IndexStep:
+			if !v.CanIterateElements() || v.HasIndex(step.Key) != cty.True {
+				return cty.NullVal(cty.DynamicPseudoType)
+			}
+			v = v.Index(step.Key)
+		}
+	}
+
+	return v
+}
+
+// configSet reports whether the value at path is set, counting values that
+// aren't known yet as set. Empty strings, lists and sets aren't.
+func configSet(config cty.Value, path cty.Path) bool {
+	v := configValue(config, path)
+	switch {
+	case !v.IsKnown():
+		return true
+	case v.IsNull():
+		return false
+	case v.Type() == cty.String:
+		return v.AsString() != ""
+	case v.CanIterateElements():
+		return v.LengthInt() > 0
+	}
+
+	return true
+}
+
+// configBool returns the value of the boolean at path, which is false when
+// it isn't set or isn't known yet.
+func configBool(config cty.Value, path cty.Path) bool {
+	v := configValue(config, path)
+	return v.IsKnown() && !v.IsNull() && v.Type() == cty.Bool && v.True()
+}
+
+// configInt returns the value of the number at path, and whether it is set
+// and known.
+func configInt(config cty.Value, path cty.Path) (int64, bool) {
+	v := configValue(config, path)
+	if !v.IsKnown() || v.IsNull() || v.Type() != cty.Number {
+		return 0, false
+	}
+
+	i, _ := v.AsBigFloat().Int64()
+	return i, true
+}
+
+// configString returns the value of the string at path, and whether it is
+// set and known.
+func configString(config cty.Value, path cty.Path) (string, bool) {
+	v := configValue(config, path)
+	if !v.IsKnown() || v.IsNull() || v.Type() != cty.String {
+		return "", false
+	}
+
+	return v.AsString(), true
+}
+
+// configContains reports whether the list or set at path contains the known
+// string s.
+func configContains(config cty.Value, path cty.Path, s string) bool {
+	v := configValue(config, path)
+	if !v.IsKnown() || v.IsNull() || !v.CanIterateElements() {
+		return false
+	}
+
+	for it := v.ElementIterator(); it.Next(); {
+		_, e := it.Element()
+		if e.IsKnown() && !e.IsNull() && e.Type() == cty.String && e.AsString() == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,278 @@
+package fusionauth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func Test_configRules(t *testing.T) {
+	block := func(attributes map[string]cty.Value) cty.Value {
+		return cty.ListVal([]cty.Value{cty.ObjectVal(attributes)})
+	}
+	scimLambdas := func(unset string) cty.Value {
+		attributes := map[string]cty.Value{}
+		for _, attribute := range scimLambdaAttributes {
+			attributes[attribute] = cty.StringVal("0d8c6dd1-5f5f-4bc1-bfe6-b3ed7f4e1f0f")
+		}
+		if unset != "" {
+			attributes[unset] = cty.NullVal(cty.String)
+		}
+		return block(attributes)
+	}
+	aclEntries := func(entries ...[2]cty.Value) map[string]cty.Value {
+		list := make([]cty.Value, 0, len(entries))
+		for _, e := range entries {
+			list = append(list, cty.ObjectVal(map[string]cty.Value{
+				"action":           cty.StringVal("Allow"),
+				"start_ip_address": e[0],
+				"end_ip_address":   e[1],
+			}))
+		}
+		return map[string]cty.Value{"entry": cty.ListVal(list)}
+	}
+	wildcard := [2]cty.Value{cty.StringVal("*"), cty.NullVal(cty.String)}
+	passwordAges := func(seconds, days cty.Value) map[string]cty.Value {
+		return map[string]cty.Value{
+			"minimum_password_age": block(map[string]cty.Value{"enabled": cty.True, "seconds": seconds}),
+			"maximum_password_age": block(map[string]cty.Value{"enabled": cty.True, "days": days}),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		rule     configRule
+		config   map[string]cty.Value
+		wantPath cty.Path
+	}{
+		{
+			name:     "RSA key without length",
+			rule:     validateKeyLength,
+			config:   map[string]cty.Value{"algorithm": cty.StringVal("RS256"), "length": cty.NullVal(cty.Number)},
+			wantPath: cty.GetAttrPath("length"),
+		},
+		{
+			name:     "RSA key with unsupported length",
+			rule:     validateKeyLength,
+			config:   map[string]cty.Value{"algorithm": cty.StringVal("RS256"), "length": cty.NumberIntVal(1024)},
+			wantPath: cty.GetAttrPath("length"),
+		},
+		{
+			name:   "RSA key with length",
+			rule:   validateKeyLength,
+			config: map[string]cty.Value{"algorithm": cty.StringVal("RS384"), "length": cty.NumberIntVal(3072)},
+		},
+		{
+			name:   "RSA key with unknown length",
+			rule:   validateKeyLength,
+			config: map[string]cty.Value{"algorithm": cty.StringVal("RS256"), "length": cty.UnknownVal(cty.Number)},
+		},
+		{
+			name:     "EC key with the wrong length",
+			rule:     validateKeyLength,
+			config:   map[string]cty.Value{"algorithm": cty.StringVal("ES384"), "length": cty.NumberIntVal(256)},
+			wantPath: cty.GetAttrPath("length"),
+		},
+		{
+			name:   "EC key without length",
+			rule:   validateKeyLength,
+			config: map[string]cty.Value{"algorithm": cty.StringVal("ES512"), "length": cty.NullVal(cty.Number)},
+		},
+		{
+			name:   "HMAC key",
+			rule:   validateKeyLength,
+			config: map[string]cty.Value{"algorithm": cty.StringVal("HS256"), "length": cty.NullVal(cty.Number)},
+		},
+		{
+			name: "authorization code grant without redirect URLs",
+			rule: validateOAuthGrants,
+			config: map[string]cty.Value{"oauth_configuration": block(map[string]cty.Value{
+				"enabled_grants":           cty.SetVal([]cty.Value{cty.StringVal("authorization_code"), cty.StringVal("refresh_token")}),
+				"authorized_redirect_urls": cty.ListValEmpty(cty.String),
+			})},
+			wantPath: cty.GetAttrPath("oauth_configuration").IndexInt(0).GetAttr("authorized_redirect_urls"),
+		},
+		{
+			name: "authorization code grant with redirect URLs",
+			rule: validateOAuthGrants,
+			config: map[string]cty.Value{"oauth_configuration": block(map[string]cty.Value{
+				"enabled_grants":           cty.SetVal([]cty.Value{cty.StringVal("authorization_code")}),
+				"authorized_redirect_urls": cty.ListVal([]cty.Value{cty.StringVal("https://example.com/callback")}),
+			})},
+		},
+		{
+			name: "authorization code grant with unknown redirect URLs",
+			rule: validateOAuthGrants,
+			config: map[string]cty.Value{"oauth_configuration": block(map[string]cty.Value{
+				"enabled_grants":           cty.SetVal([]cty.Value{cty.StringVal("authorization_code")}),
+				"authorized_redirect_urls": cty.UnknownVal(cty.List(cty.String)),
+			})},
+		},
+		{
+			name: "device code grant without verification URL",
+			rule: validateOAuthGrants,
+			config: map[string]cty.Value{"oauth_configuration": block(map[string]cty.Value{
+				"enabled_grants":          cty.SetVal([]cty.Value{cty.StringVal("device_code")}),
+				"device_verification_url": cty.NullVal(cty.String),
+			})},
+			wantPath: cty.GetAttrPath("oauth_configuration").IndexInt(0).GetAttr("device_verification_url"),
+		},
+		{
+			name:   "no OAuth configuration",
+			rule:   validateOAuthGrants,
+			config: map[string]cty.Value{"oauth_configuration": cty.ListValEmpty(cty.Object(map[string]cty.Type{"enabled_grants": cty.Set(cty.String)}))},
+		},
+		{
+			name: "SCIM without lambda configuration",
+			rule: validateSCIMConfiguration,
+			config: map[string]cty.Value{
+				"scim_server_configuration": block(map[string]cty.Value{"enabled": cty.True}),
+				"lambda_configuration":      cty.NullVal(cty.List(cty.Object(map[string]cty.Type{}))),
+			},
+			wantPath: cty.GetAttrPath("lambda_configuration"),
+		},
+		{
+			name: "SCIM without a lambda",
+			rule: validateSCIMConfiguration,
+			config: map[string]cty.Value{
+				"scim_server_configuration": block(map[string]cty.Value{"enabled": cty.True}),
+				"lambda_configuration":      scimLambdas("scim_group_response_converter_id"),
+			},
+			wantPath: cty.GetAttrPath("lambda_configuration").IndexInt(0).GetAttr("scim_group_response_converter_id"),
+		},
+		{
+			name: "SCIM with lambdas",
+			rule: validateSCIMConfiguration,
+			config: map[string]cty.Value{
+				"scim_server_configuration": block(map[string]cty.Value{"enabled": cty.True}),
+				"lambda_configuration":      scimLambdas(""),
+			},
+		},
+		{
+			name: "SCIM disabled",
+			rule: validateSCIMConfiguration,
+			config: map[string]cty.Value{
+				"scim_server_configuration": block(map[string]cty.Value{"enabled": cty.False}),
+			},
+		},
+		{
+			name:     "minimum password age above maximum",
+			rule:     validatePasswordAges,
+			config:   passwordAges(cty.NumberIntVal(2*24*60*60), cty.NumberIntVal(1)),
+			wantPath: cty.GetAttrPath("minimum_password_age").IndexInt(0).GetAttr("seconds"),
+		},
+		{
+			name:   "minimum password age below maximum",
+			rule:   validatePasswordAges,
+			config: passwordAges(cty.NumberIntVal(60), cty.NumberIntVal(1)),
+		},
+		{
+			name:   "default password ages",
+			rule:   validatePasswordAges,
+			config: passwordAges(cty.NullVal(cty.Number), cty.NullVal(cty.Number)),
+		},
+		{
+			name:   "unknown password age",
+			rule:   validatePasswordAges,
+			config: passwordAges(cty.UnknownVal(cty.Number), cty.NumberIntVal(1)),
+		},
+		{
+			name:   "IP access control list",
+			rule:   validateIPAccessControlEntries,
+			config: aclEntries(wildcard, [2]cty.Value{cty.StringVal("10.0.0.0/8"), cty.NullVal(cty.String)}, [2]cty.Value{cty.StringVal("192.168.0.1"), cty.StringVal("192.168.0.9")}),
+		},
+		{
+			name:     "IP access control list without wildcard",
+			rule:     validateIPAccessControlEntries,
+			config:   aclEntries([2]cty.Value{cty.StringVal("10.0.0.1"), cty.NullVal(cty.String)}),
+			wantPath: cty.GetAttrPath("entry"),
+		},
+		{
+			name:     "IP access control list with two wildcards",
+			rule:     validateIPAccessControlEntries,
+			config:   aclEntries(wildcard, wildcard),
+			wantPath: cty.GetAttrPath("entry"),
+		},
+		{
+			name:   "IP access control list with unknown start",
+			rule:   validateIPAccessControlEntries,
+			config: aclEntries([2]cty.Value{cty.UnknownVal(cty.String), cty.NullVal(cty.String)}),
+		},
+		{
+			name:     "IP access control entry ending before its start",
+			rule:     validateIPAccessControlEntries,
+			config:   aclEntries(wildcard, [2]cty.Value{cty.StringVal("10.0.0.9"), cty.StringVal("10.0.0.1")}),
+			wantPath: cty.GetAttrPath("entry").IndexInt(1).GetAttr("end_ip_address"),
+		},
+		{
+			name:     "IP access control entry mixing IPv4 and IPv6",
+			rule:     validateIPAccessControlEntries,
+			config:   aclEntries(wildcard, [2]cty.Value{cty.StringVal("10.0.0.1"), cty.StringVal("::1")}),
+			wantPath: cty.GetAttrPath("entry").IndexInt(1).GetAttr("end_ip_address"),
+		},
+		{
+			name:     "IP access control entry with CIDR block and end",
+			rule:     validateIPAccessControlEntries,
+			config:   aclEntries(wildcard, [2]cty.Value{cty.StringVal("10.0.0.0/24"), cty.StringVal("10.0.0.9")}),
+			wantPath: cty.GetAttrPath("entry").IndexInt(1).GetAttr("end_ip_address"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule(cty.ObjectVal(tt.config))
+			if tt.wantPath == nil {
+				if err != nil {
+					t.Fatalf("rule() = %s, want no error", err)
+				}
+				return
+			}
+
+			var pathErr cty.PathError
+			if !errors.As(err, &pathErr) {
+				t.Fatalf("rule() = %v, want a cty.PathError", err)
+			}
+			if !pathErr.Path.Equals(tt.wantPath) {
+				t.Errorf("rule() path = %#v, want %#v", pathErr.Path, tt.wantPath)
+			}
+		})
+	}
+}
+
+func Test_validateConfig_pinsDiagnostic(t *testing.T) {
+	server := testProviderServer(t)
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %s", err)
+	}
+	typ := schemaResp.ResourceSchemas["fusionauth_key"].ValueType()
+
+	config := testDynamicValue(t, typ, map[string]tftypes.Value{
+		"algorithm": tftypes.NewValue(tftypes.String, "RS256"),
+		"name":      tftypes.NewValue(tftypes.String, "signing"),
+	})
+	prior, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, nil))
+	if err != nil {
+		t.Fatalf("NewDynamicValue: %s", err)
+	}
+
+	resp, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         "fusionauth_key",
+		PriorState:       &prior,
+		ProposedNewState: config,
+		Config:           config,
+	})
+	if err != nil {
+		t.Fatalf("PlanResourceChange: %s", err)
+	}
+
+	if len(resp.Diagnostics) != 1 {
+		t.Fatalf("got %d diagnostics, want 1", len(resp.Diagnostics))
+	}
+	if want := tftypes.NewAttributePath().WithAttributeName("length"); !resp.Diagnostics[0].Attribute.Equal(want) {
+		t.Errorf("diagnostic attribute = %s, want %s", resp.Diagnostics[0].Attribute, want)
+	}
+}
@@ -0,0 +1,49 @@
+package fusionauth
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceSchemaFromResourceSchema returns a copy of a resource's schema for
+// a data source that reads the same object, so the resource's read mapping
+// can populate it. Every attribute becomes computed, and keeps its type,
+// description and sensitivity. Write-only attributes and their versions are
+// left out, as they are never read, as are the attributes named in omit,
+// e.g. those only used when creating the object.
+func dataSourceSchemaFromResourceSchema(resourceSchema map[string]*schema.Schema, omit ...string) map[string]*schema.Schema {
+	omitted := make(map[string]bool, len(omit))
+	for _, k := range omit {
+		omitted[k] = true
+	}
+
+	dataSourceSchema := make(map[string]*schema.Schema, len(resourceSchema))
+	for k, s := range resourceSchema {
+		if omitted[k] || s.WriteOnly || strings.HasSuffix(k, "_wo_version") {
+			continue
+		}
+		dataSourceSchema[k] = computedSchema(s)
+	}
+
+	return dataSourceSchema
+}
+
+func computedSchema(s *schema.Schema) *schema.Schema {
+	c := &schema.Schema{
+		Type:        s.Type,
+		Computed:    true,
+		Sensitive:   s.Sensitive,
+		Description: s.Description,
+		Set:         s.Set,
+	}
+
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		c.Elem = &schema.Resource{Schema: dataSourceSchemaFromResourceSchema(elem.Schema)}
+	case *schema.Schema:
+		c.Elem = &schema.Schema{Type: elem.Type, Elem: elem.Elem}
+	}
+
+	return c
+}
@@ -0,0 +1,99 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// deletionInitialBackoff and deletionMaxBackoff bound the delay between
+// checks while waiting for an asynchronous delete to finish.
+var (
+	deletionInitialBackoff = time.Second
+	deletionMaxBackoff     = 10 * time.Second
+)
+
+// retrieveStateFunc retrieves an object, returning the status code of the
+// response and the state of the object.
+type retrieveStateFunc func(ctx context.Context) (int, fusionauth.ObjectState, error)
+
+// waitForDeletion polls the object until FusionAuth no longer finds it, or
+// its state no longer reports a pending delete. FusionAuth deletes tenants and
+// applications asynchronously, so without waiting a recreate with the same Id,
+// or deleting the resource the object belongs to, races with the delete. The
+// wait is bounded by ctx, which carries the operation's timeout.
+func waitForDeletion(ctx context.Context, kind, id string, retrieve retrieveStateFunc) error {
+	backoff := deletionInitialBackoff
+	for {
+		status, state, err := retrieve(ctx)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return fmt.Errorf("timed out waiting for %s %s to be deleted", kind, id)
+		case err != nil:
+			return err
+		case status == http.StatusNotFound:
+			return nil
+		case status != http.StatusOK:
+			return fmt.Errorf("waiting for %s %s to be deleted: unexpected status code: %d(%s)", kind, id, status, http.StatusText(status))
+		case state != fusionauth.ObjectState_PendingDelete:
+			return nil
+		}
+		tflog.Debug(ctx, "Waiting for delete to finish", map[string]interface{}{kind + "_id": id})
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for %s %s to be deleted", kind, id)
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > deletionMaxBackoff {
+			backoff = deletionMaxBackoff
+		}
+	}
+}
+
+// waitForPendingDeletion waits for a pending delete of the object to finish
+// before it is created again with the same Id. Nothing is waited for when the
+// object doesn't exist, or isn't being deleted, in which case creating it
+// fails as usual.
+func waitForPendingDeletion(ctx context.Context, kind, id string, retrieve retrieveStateFunc) error {
+	if id == "" {
+		return nil
+	}
+
+	status, state, err := retrieve(ctx)
+	if err != nil || status != http.StatusOK || state != fusionauth.ObjectState_PendingDelete {
+		return nil
+	}
+
+	tflog.Info(ctx, "Waiting for a pending delete to finish before creating", map[string]interface{}{kind + "_id": id})
+	return waitForDeletion(ctx, kind, id, retrieve)
+}
+
+// retrieveTenantState returns a retrieveStateFunc for the tenant id.
+func retrieveTenantState(client Client, id string) retrieveStateFunc {
+	return func(ctx context.Context) (int, fusionauth.ObjectState, error) {
+		resp, _, err := client.FAClient.RetrieveTenantWithContext(ctx, id)
+		if err != nil {
+			return 0, "", err
+		}
+		return resp.StatusCode, resp.Tenant.State, nil
+	}
+}
+
+// retrieveApplicationState returns a retrieveStateFunc for the application
+// id.
+func retrieveApplicationState(client Client, id string) retrieveStateFunc {
+	return func(ctx context.Context) (int, fusionauth.ObjectState, error) {
+		resp, err := client.FAClient.RetrieveApplicationWithContext(ctx, id)
+		if err != nil {
+			return 0, "", err
+		}
+		return resp.StatusCode, resp.Application.State, nil
+	}
+}
@@ -0,0 +1,145 @@
+package fusionauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func fastDeletionBackoff(t *testing.T) {
+	t.Helper()
+
+	initial, maxBackoff := deletionInitialBackoff, deletionMaxBackoff
+	deletionInitialBackoff, deletionMaxBackoff = time.Millisecond, 5*time.Millisecond
+	t.Cleanup(func() { deletionInitialBackoff, deletionMaxBackoff = initial, maxBackoff })
+}
+
+func Test_waitForDeletion(t *testing.T) {
+	fastDeletionBackoff(t)
+
+	type result struct {
+		status int
+		state  fusionauth.ObjectState
+		err    error
+	}
+	pending := result{http.StatusOK, fusionauth.ObjectState_PendingDelete, nil}
+
+	tests := []struct {
+		name      string
+		results   []result
+		timeout   time.Duration
+		wantErr   bool
+		wantCalls int
+	}{
+		{
+			name:      "already deleted",
+			results:   []result{{status: http.StatusNotFound}},
+			wantCalls: 1,
+		},
+		{
+			name:      "pending delete",
+			results:   []result{pending, pending, {status: http.StatusNotFound}},
+			wantCalls: 3,
+		},
+		{
+			name:      "state reports the delete finished",
+			results:   []result{pending, {http.StatusOK, fusionauth.ObjectState_Active, nil}},
+			wantCalls: 2,
+		},
+		{
+			name:      "unexpected status",
+			results:   []result{{status: http.StatusInternalServerError}},
+			wantErr:   true,
+			wantCalls: 1,
+		},
+		{
+			name:    "request error",
+			results: []result{{err: errors.New("connection refused")}},
+			wantErr: true,
+		},
+		{
+			name:    "timeout",
+			results: []result{pending},
+			timeout: 20 * time.Millisecond,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			calls := 0
+			err := waitForDeletion(ctx, "tenant", "id", func(context.Context) (int, fusionauth.ObjectState, error) {
+				r := tt.results[min(calls, len(tt.results)-1)]
+				calls++
+				return r.status, r.state, r.err
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("waitForDeletion() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantCalls > 0 && calls != tt.wantCalls {
+				t.Errorf("retrieved %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func Test_deleteTenant_waitsForAsyncDelete(t *testing.T) {
+	fastDeletionBackoff(t)
+	fake, client := newFakeFusionAuthClient(t)
+	fake.asyncDeletes = 2
+	ctx := context.Background()
+
+	tenantID, _ := uuid.GenerateUUID()
+	data := schema.TestResourceDataRaw(t, newTenant().Schema, map[string]interface{}{
+		"name":      "async",
+		"tenant_id": tenantID,
+	})
+	if diags := createTenant(ctx, data, client); diags.HasError() {
+		t.Fatalf("createTenant: %v", diags)
+	}
+
+	if diags := deleteTenant(ctx, data, client); diags.HasError() {
+		t.Fatalf("deleteTenant: %v", diags)
+	}
+	if _, ok := fake.object("tenant", tenantID); ok {
+		t.Error("deleteTenant returned before the delete finished")
+	}
+}
+
+func Test_createApplication_waitsForPendingDelete(t *testing.T) {
+	fastDeletionBackoff(t)
+	fake, client := newFakeFusionAuthClient(t)
+	ctx := context.Background()
+
+	applicationID, _ := uuid.GenerateUUID()
+	fake.seed("application", map[string]interface{}{
+		"id":       applicationID,
+		"name":     "Deleted",
+		"tenantId": fakeDefaultTenantID,
+		"state":    "PendingDelete",
+	})
+	fake.pendingDeletes[applicationID] = 2
+
+	data := schema.TestResourceDataRaw(t, newApplication().Schema, map[string]interface{}{
+		"name":           "Recreated",
+		"application_id": applicationID,
+	})
+	if diags := createApplication(ctx, data, client); diags.HasError() {
+		t.Fatalf("createApplication: %v", diags)
+	}
+	if app, ok := fake.object("application", applicationID); !ok || app["name"] != "Recreated" {
+		t.Errorf("application = %v, want it recreated", app)
+	}
+}
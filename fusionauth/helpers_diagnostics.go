@@ -0,0 +1,161 @@
+package fusionauth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// responseError is returned by checkResponse for non-2xx API responses and
+// keeps hold of the structured FusionAuth errors, if any were returned.
+type responseError struct {
+	StatusCode int
+	Errors     *fusionauth.Errors
+}
+
+func (e *responseError) Error() string {
+	if e.Errors == nil {
+		return fmt.Sprintf("unexpected status code: %d(%s)", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+
+	return fmt.Sprintf("unexpected status code: %d(%s) Errors: %v", e.StatusCode, http.StatusText(e.StatusCode), e.Errors)
+}
+
+// responseErrorDiags converts an error into diagnostics. FusionAuth validation
+// errors each become their own diagnostic, with field errors pinned to the
+// attribute of the resource schema that the field maps to, so Terraform can
+// point at the offending configuration. prefix is the name of the object the
+// resource is sent to FusionAuth as, e.g. "tenant" for the field error
+// "tenant.emailConfiguration.host".
+func responseErrorDiags(err error, res *schema.Resource, prefix string) diag.Diagnostics {
+	var respErr *responseError
+	if !errors.As(err, &respErr) || respErr.Errors == nil || !respErr.Errors.Present() {
+		return diag.FromErr(err)
+	}
+
+	status := fmt.Sprintf("%d(%s)", respErr.StatusCode, http.StatusText(respErr.StatusCode))
+
+	var diags diag.Diagnostics
+	for _, generalError := range respErr.Errors.GeneralErrors {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  generalError.Message,
+			Detail:   fmt.Sprintf("FusionAuth responded with %s: %s", status, generalError.Code),
+		})
+	}
+
+	fields := make([]string, 0, len(respErr.Errors.FieldErrors))
+	for field := range respErr.Errors.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		path := fieldErrorPath(res, prefix, field)
+		for _, fieldError := range respErr.Errors.FieldErrors[field] {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fieldError.Message,
+				Detail:        fmt.Sprintf("FusionAuth responded with %s for field %s: %s", status, field, fieldError.Code),
+				AttributePath: path,
+			})
+		}
+	}
+
+	return diags
+}
+
+// fieldErrorPath maps a FusionAuth field name, e.g.
+// "tenant.emailConfiguration.host", to the matching attribute path in the
+// resource schema, e.g. email_configuration.0.host. Single item blocks are
+// indexed at 0. When a field can't be resolved all the way down, the path to
+// the deepest attribute found is returned, which may be empty.
+func fieldErrorPath(res *schema.Resource, prefix, field string) cty.Path {
+	if res == nil {
+		return nil
+	}
+
+	field = strings.TrimPrefix(field, prefix+".")
+	schemaMap := res.Schema
+
+	var path cty.Path
+	for _, segment := range strings.Split(field, ".") {
+		name, index, hasIndex := splitFieldIndex(segment)
+		key := camelToSnakeCase(name)
+
+		s, ok := schemaMap[key]
+		if !ok {
+			break
+		}
+		path = path.GetAttr(key)
+
+		nested, isBlock := s.Elem.(*schema.Resource)
+		if !isBlock || s.Type != schema.TypeList {
+			break
+		}
+
+		switch {
+		case hasIndex:
+			path = path.IndexInt(index)
+		case s.MaxItems == 1:
+			path = path.IndexInt(0)
+		default:
+			return path
+		}
+
+		schemaMap = nested.Schema
+	}
+
+	return path
+}
+
+// splitFieldIndex splits a field segment such as "roles[1]" into its name and
+// index.
+func splitFieldIndex(segment string) (name string, index int, ok bool) {
+	open := strings.Index(segment, "[")
+	if open == -1 || !strings.HasSuffix(segment, "]") {
+		return segment, 0, false
+	}
+
+	index, err := strconv.Atoi(segment[open+1 : len(segment)-1])
+	if err != nil {
+		return segment[:open], 0, false
+	}
+
+	return segment[:open], index, true
+}
+
+// camelToSnakeCase converts a FusionAuth JSON field name to the snake case
+// naming used by the provider's schemas, keeping acronyms together, e.g.
+// "authorizedRedirectURLs" becomes "authorized_redirect_urls".
+func camelToSnakeCase(s string) string {
+	runes := []rune(s)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			// A plural acronym such as "URLs" is a single word.
+			if nextIsLower && runes[i+1] == 's' && (i+2 == len(runes) || unicode.IsUpper(runes[i+2])) {
+				nextIsLower = false
+			}
+
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,106 @@
+package fusionauth
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func Test_camelToSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"host":                   "host",
+		"emailConfiguration":     "email_configuration",
+		"authorizedRedirectURLs": "authorized_redirect_urls",
+		"clientId":               "client_id",
+		"webhookIds":             "webhook_ids",
+		"issuer":                 "issuer",
+		"loginIdInUseOnCreate":   "login_id_in_use_on_create",
+		"twoFactorTOTPEnabled":   "two_factor_totp_enabled",
+		"jwtConfiguration":       "jwt_configuration",
+		"accessTokenKeyId":       "access_token_key_id",
+		"URL":                    "url",
+	}
+
+	for in, want := range tests {
+		if got := camelToSnakeCase(in); got != want {
+			t.Errorf("camelToSnakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func Test_fieldErrorPath(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		field  string
+		want   cty.Path
+	}{
+		{
+			name:   "top level",
+			prefix: "tenant",
+			field:  "tenant.name",
+			want:   cty.GetAttrPath("name"),
+		},
+		{
+			name:   "single item block",
+			prefix: "tenant",
+			field:  "tenant.emailConfiguration.host",
+			want:   cty.GetAttrPath("email_configuration").IndexInt(0).GetAttr("host"),
+		},
+		{
+			name:   "unknown nested field",
+			prefix: "tenant",
+			field:  "tenant.emailConfiguration.doesNotExist",
+			want:   cty.GetAttrPath("email_configuration").IndexInt(0),
+		},
+		{
+			name:   "unknown field",
+			prefix: "tenant",
+			field:  "tenant.doesNotExist",
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fieldErrorPath(newTenant(), tt.prefix, tt.field); !got.Equals(tt.want) {
+				t.Errorf("fieldErrorPath(%q) = %#v, want %#v", tt.field, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_responseErrorDiags(t *testing.T) {
+	err := checkResponse(http.StatusBadRequest, &fusionauth.Errors{
+		GeneralErrors: []fusionauth.Error{{Code: "[general]", Message: "Something went wrong."}},
+		FieldErrors: map[string][]fusionauth.Error{
+			"application.oauthConfiguration.authorizedRedirectURLs": {
+				{Code: "[invalid]application.oauthConfiguration.authorizedRedirectURLs", Message: "Invalid redirect URL."},
+			},
+			"application.name": {
+				{Code: "[blank]application.name", Message: "You must specify the [application.name] property."},
+			},
+		},
+	})
+
+	diags := responseErrorDiags(err, newApplication(), "application")
+	if len(diags) != 3 {
+		t.Fatalf("got %d diagnostics, want 3: %v", len(diags), diags)
+	}
+
+	if diags[0].AttributePath != nil || diags[0].Summary != "Something went wrong." {
+		t.Errorf("general error diagnostic = %+v", diags[0])
+	}
+	if want := cty.GetAttrPath("name"); !diags[1].AttributePath.Equals(want) {
+		t.Errorf("diags[1].AttributePath = %#v, want %#v", diags[1].AttributePath, want)
+	}
+	if want := cty.GetAttrPath("oauth_configuration").IndexInt(0).GetAttr("authorized_redirect_urls"); !diags[2].AttributePath.Equals(want) {
+		t.Errorf("diags[2].AttributePath = %#v, want %#v", diags[2].AttributePath, want)
+	}
+
+	if diags := responseErrorDiags(checkResponse(http.StatusNotFound, nil), newApplication(), "application"); len(diags) != 1 || diags[0].Summary != "unexpected status code: 404(Not Found)" {
+		t.Errorf("plain error diagnostics = %v", diags)
+	}
+}
@@ -0,0 +1,389 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// importNamePrefix marks the part of an import ID that is looked up by name.
+const importNamePrefix = "name:"
+
+// parseImportID parses an import ID against formats, and returns the parts of
+// the first format it matches by name. A format names its parts, separated by
+// "/", e.g. "application_id/role_id", or by ":" for the formats earlier
+// versions of the provider accepted. Parts ending in _id must be UUIDs, and
+// the part "name" must be given as name:<name>. The last part takes the rest
+// of the import ID, so names may contain the separator.
+func parseImportID(id string, formats ...string) (map[string]string, error) {
+	for _, format := range formats {
+		if parts, ok := matchImportID(id, format); ok {
+			return parts, nil
+		}
+	}
+
+	expected := make([]string, 0, len(formats))
+	for _, format := range formats {
+		if strings.Contains(format, ":") {
+			// Still accepted, but no longer documented.
+			continue
+		}
+		expected = append(expected, importIDFormatString(format))
+	}
+	if len(expected) == 1 {
+		return nil, fmt.Errorf("invalid import ID %q, expected %s", id, expected[0])
+	}
+	return nil, fmt.Errorf("invalid import ID %q, expected one of %s or %s",
+		id, strings.Join(expected[:len(expected)-1], ", "), expected[len(expected)-1])
+}
+
+func matchImportID(id, format string) (map[string]string, bool) {
+	separator := "/"
+	if strings.Contains(format, ":") {
+		separator = ":"
+	}
+
+	names := strings.Split(format, separator)
+	values := strings.SplitN(id, separator, len(names))
+	if len(values) != len(names) {
+		return nil, false
+	}
+
+	parts := make(map[string]string, len(names))
+	for i, name := range names {
+		value := values[i]
+		switch {
+		case name == "name":
+			if !strings.HasPrefix(value, importNamePrefix) || value == importNamePrefix {
+				return nil, false
+			}
+			value = strings.TrimPrefix(value, importNamePrefix)
+		case strings.HasSuffix(name, "_id"):
+			if _, errs := validation.IsUUID(value, name); len(errs) > 0 {
+				return nil, false
+			}
+		default:
+			if value == "" || strings.HasPrefix(value, importNamePrefix) {
+				return nil, false
+			}
+		}
+		parts[name] = value
+	}
+
+	return parts, true
+}
+
+// importIDFormatString renders a format for error messages, e.g.
+// "<application_id>/name:<name>".
+func importIDFormatString(format string) string {
+	names := strings.Split(format, "/")
+	for i, name := range names {
+		if name == "name" {
+			names[i] = importNamePrefix + "<name>"
+			continue
+		}
+		names[i] = "<" + name + ">"
+	}
+	return strings.Join(names, "/")
+}
+
+// importLister lists the objects an import by name looks among.
+type importLister func(ctx context.Context, client Client) ([]exportObject, error)
+
+// lookupImportName returns the Id of the only object called name. Names are
+// compared exactly, as FusionAuth does when it enforces their uniqueness.
+func lookupImportName(ctx context.Context, client Client, kind, name string, list importLister) (string, error) {
+	objects, err := list(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, o := range objects {
+		if o.Name == name {
+			ids = append(ids, o.ID)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s named %q was found", kind, name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("found %d %ss named %q, with the Ids %s. Import one of them by its Id instead",
+			len(ids), kind, name, strings.Join(ids, ", "))
+	}
+}
+
+// importByName returns an importer that accepts the Id of the object, or
+// name:<name>. Tenant scoped objects are looked up in the provider's tenant,
+// when it has one, and also accept <tenant_id>/name:<name> to look in another
+// tenant, which then becomes the resource's tenant_id.
+func importByName(kind string, list importLister, tenantScoped bool) schema.StateContextFunc {
+	// The most specific formats come first, as an Id matches anything.
+	formats := []string{"name", "id"}
+	if tenantScoped {
+		formats = append([]string{"tenant_id/name"}, formats...)
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+		parts, err := parseImportID(d.Id(), formats...)
+		if err != nil {
+			return nil, err
+		}
+
+		id := parts["id"]
+		if name, ok := parts["name"]; ok {
+			client := i.(Client).withTenantID(parts["tenant_id"])
+			if !tenantScoped {
+				client.FAClient.TenantId = ""
+			}
+			if id, err = lookupImportName(ctx, client, kind, name, list); err != nil {
+				return nil, err
+			}
+		}
+		d.SetId(id)
+
+		if tenantID, ok := parts["tenant_id"]; ok {
+			if err := d.Set("tenant_id", tenantID); err != nil {
+				return nil, err
+			}
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// lookupChildName returns the Id of the only child object called name, among
+// the named children of its parent.
+func lookupChildName(kind, name string, children map[string]string) (string, error) {
+	var ids []string
+	for id, childName := range children {
+		if childName == name {
+			ids = append(ids, id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s named %q was found", kind, name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("found %d %ss named %q. Import one of them by its Id instead", len(ids), kind, name)
+	}
+}
+
+func listImportConsents(ctx context.Context, client Client) ([]exportObject, error) {
+	resp, err := client.FAClient.RetrieveConsentsWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing consents: %w", err)
+	}
+
+	objects := make([]exportObject, 0, len(resp.Consents))
+	for _, c := range resp.Consents {
+		objects = append(objects, exportObject{ID: c.Id, Name: c.Name})
+	}
+	return objects, nil
+}
+
+func listImportEmailTemplates(ctx context.Context, client Client) ([]exportObject, error) {
+	resp, err := client.FAClient.RetrieveEmailTemplatesWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing email templates: %w", err)
+	}
+
+	objects := make([]exportObject, 0, len(resp.EmailTemplates))
+	for _, e := range resp.EmailTemplates {
+		objects = append(objects, exportObject{ID: e.Id, Name: e.Name})
+	}
+	return objects, nil
+}
+
+// listImportEntities returns an importLister for the entities named name.
+func listImportEntities(name string) importLister {
+	return func(ctx context.Context, client Client) ([]exportObject, error) {
+		entities, err := searchEntities(ctx, client, name, "")
+		if err != nil {
+			return nil, fmt.Errorf("listing entities: %w", err)
+		}
+
+		objects := make([]exportObject, 0, len(entities))
+		for _, e := range entities {
+			objects = append(objects, exportObject{ID: e.Id, Name: e.Name})
+		}
+		return objects, nil
+	}
+}
+
+func listImportEntityTypes(ctx context.Context, client Client) ([]exportObject, error) {
+	resp, faErrs, err := client.FAClient.RetrieveEntityTypesWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, faErrs)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing entity types: %w", err)
+	}
+
+	objects := make([]exportObject, 0, len(resp.EntityTypes))
+	for _, e := range resp.EntityTypes {
+		objects = append(objects, exportObject{ID: e.Id, Name: e.Name})
+	}
+	return objects, nil
+}
+
+func listImportForms(ctx context.Context, client Client) ([]exportObject, error) {
+	resp, err := client.FAClient.RetrieveFormsWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing forms: %w", err)
+	}
+
+	objects := make([]exportObject, 0, len(resp.Forms))
+	for _, f := range resp.Forms {
+		objects = append(objects, exportObject{ID: f.Id, Name: f.Name})
+	}
+	return objects, nil
+}
+
+func listImportFormFields(ctx context.Context, client Client) ([]exportObject, error) {
+	resp, err := client.FAClient.RetrieveFormFieldsWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing form fields: %w", err)
+	}
+
+	objects := make([]exportObject, 0, len(resp.Fields))
+	for _, f := range resp.Fields {
+		objects = append(objects, exportObject{ID: f.Id, Name: f.Name})
+	}
+	return objects, nil
+}
+
+func listImportGroups(ctx context.Context, client Client) ([]exportObject, error) {
+	resp, err := client.FAClient.RetrieveGroupsWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing groups: %w", err)
+	}
+
+	objects := make([]exportObject, 0, len(resp.Groups))
+	for _, g := range resp.Groups {
+		objects = append(objects, exportObject{ID: g.Id, Name: g.Name})
+	}
+	return objects, nil
+}
+
+func listImportUserActions(ctx context.Context, client Client) ([]exportObject, error) {
+	resp, err := client.FAClient.RetrieveUserActionsWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing user actions: %w", err)
+	}
+
+	objects := make([]exportObject, 0, len(resp.UserActions))
+	for _, a := range resp.UserActions {
+		objects = append(objects, exportObject{ID: a.Id, Name: a.Name})
+	}
+	return objects, nil
+}
+
+func listImportSMSMessageTemplates(ctx context.Context, client Client) ([]exportObject, error) {
+	resp, err := client.FAClient.RetrieveMessageTemplatesWithContext(ctx)
+	if err == nil {
+		err = checkResponse(resp.StatusCode, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing message templates: %w", err)
+	}
+
+	objects := make([]exportObject, 0, len(resp.MessageTemplates))
+	for _, m := range resp.MessageTemplates {
+		if m.Type == fusionauth.MessageType_SMS {
+			objects = append(objects, exportObject{ID: m.Id, Name: m.Name})
+		}
+	}
+	return objects, nil
+}
+
+// listImportConnectors returns an importLister for the connectors of
+// connectorType.
+func listImportConnectors(connectorType fusionauth.ConnectorType) importLister {
+	return func(ctx context.Context, client Client) ([]exportObject, error) {
+		resp, err := client.FAClient.RetrieveConnectorsWithContext(ctx)
+		if err == nil {
+			err = checkResponse(resp.StatusCode, nil)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing connectors: %w", err)
+		}
+
+		var objects []exportObject
+		for _, c := range resp.Connectors {
+			if c.Type == connectorType {
+				objects = append(objects, exportObject{ID: c.Id, Name: c.Name})
+			}
+		}
+		return objects, nil
+	}
+}
+
+// listImportMessengers returns an importLister for the messengers of
+// messengerType.
+func listImportMessengers(messengerType fusionauth.MessengerType) importLister {
+	return func(ctx context.Context, client Client) ([]exportObject, error) {
+		resp, err := client.FAClient.RetrieveMessengersWithContext(ctx)
+		if err == nil {
+			err = checkResponse(resp.StatusCode, nil)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing messengers: %w", err)
+		}
+
+		var objects []exportObject
+		for _, m := range resp.Messengers {
+			if m.Type == messengerType {
+				objects = append(objects, exportObject{ID: m.Id, Name: m.Name})
+			}
+		}
+		return objects, nil
+	}
+}
+
+// listImportIdentityProviders returns an importLister for the identity
+// providers managed by resourceType.
+func listImportIdentityProviders(resourceType string) importLister {
+	return func(ctx context.Context, client Client) ([]exportObject, error) {
+		all, err := listExportIdentityProviders(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+
+		var objects []exportObject
+		for _, o := range all {
+			if o.ResourceType == resourceType {
+				objects = append(objects, o)
+			}
+		}
+		return objects, nil
+	}
+}
@@ -0,0 +1,262 @@
+package fusionauth
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Test_parseImportID(t *testing.T) {
+	const (
+		appID  = "3c219e58-ed0e-4b18-ad48-f4f92793ae32"
+		roleID = "0b1a0b0e-5c3c-4f3b-8a33-7d0a4d8f2c11"
+	)
+	formats := []string{"application_id/role_id", "application_id/name", "application_id:role_id"}
+
+	tests := []struct {
+		name    string
+		id      string
+		formats []string
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name:    "composite",
+			id:      appID + "/" + roleID,
+			formats: formats,
+			want:    map[string]string{"application_id": appID, "role_id": roleID},
+		},
+		{
+			name:    "name",
+			id:      appID + "/name:admin",
+			formats: formats,
+			want:    map[string]string{"application_id": appID, "name": "admin"},
+		},
+		{
+			name:    "name containing the separator",
+			id:      appID + "/name:a/b",
+			formats: formats,
+			want:    map[string]string{"application_id": appID, "name": "a/b"},
+		},
+		{
+			name:    "legacy separator",
+			id:      appID + ":" + roleID,
+			formats: formats,
+			want:    map[string]string{"application_id": appID, "role_id": roleID},
+		},
+		{
+			name:    "plain id",
+			id:      "not-a-uuid",
+			formats: []string{"name", "id"},
+			want:    map[string]string{"id": "not-a-uuid"},
+		},
+		{
+			name:    "invalid uuid",
+			id:      "app/" + roleID,
+			formats: formats,
+			wantErr: `invalid import ID "app/` + roleID + `", expected one of <application_id>/<role_id> or <application_id>/name:<name>`,
+		},
+		{
+			name:    "empty name",
+			id:      "name:",
+			formats: []string{"name", "id"},
+			wantErr: `invalid import ID "name:", expected one of name:<name> or <id>`,
+		},
+		{
+			name:    "single format",
+			id:      roleID,
+			formats: []string{"group_id/user_id"},
+			wantErr: `invalid import ID "` + roleID + `", expected <group_id>/<user_id>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseImportID(tt.id, tt.formats...)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("parseImportID() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseImportID() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseImportID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_importByName(t *testing.T) {
+	fake, client := newFakeFusionAuthClient(t)
+	ctx := context.Background()
+
+	const (
+		tenantID = "1d2f3a4b-5c6d-4e7f-8a9b-0c1d2e3f4a5b"
+		lambdaID = "7f0d1c2b-3a49-4c8d-9e6f-5a4b3c2d1e0f"
+		groupID  = "2b3c4d5e-6f70-4a8b-9c0d-1e2f3a4b5c6d"
+	)
+	fake.seed("tenant", map[string]interface{}{"id": tenantID, "name": "Other"})
+	fake.seed("lambda", map[string]interface{}{"id": lambdaID, "name": "Populate", "type": "JWTPopulate"})
+	for _, id := range []string{"4c5d6e7f-8091-4a2b-8c3d-4e5f60718293", "5d6e7f80-91a2-4b3c-9d4e-5f6071829304"} {
+		fake.seed("lambda", map[string]interface{}{"id": id, "name": "Twice", "type": "JWTPopulate"})
+	}
+	fake.seed("group", map[string]interface{}{"id": groupID, "name": "Admins", "tenantId": tenantID})
+
+	tests := []struct {
+		name         string
+		resource     *schema.Resource
+		importer     schema.StateContextFunc
+		id           string
+		wantID       string
+		wantTenantID string
+		wantErr      string
+	}{
+		{
+			name:     "by id",
+			resource: newLambda(),
+			importer: importByName("lambda", listExportLambdas, false),
+			id:       lambdaID,
+			wantID:   lambdaID,
+		},
+		{
+			name:     "by name",
+			resource: newLambda(),
+			importer: importByName("lambda", listExportLambdas, false),
+			id:       "name:Populate",
+			wantID:   lambdaID,
+		},
+		{
+			name:     "unknown name",
+			resource: newLambda(),
+			importer: importByName("lambda", listExportLambdas, false),
+			id:       "name:Missing",
+			wantErr:  `no lambda named "Missing" was found`,
+		},
+		{
+			name:     "ambiguous name",
+			resource: newLambda(),
+			importer: importByName("lambda", listExportLambdas, false),
+			id:       "name:Twice",
+			wantErr:  `found 2 lambdas named "Twice"`,
+		},
+		{
+			name:         "by name in a tenant",
+			resource:     newGroup(),
+			importer:     importByName("group", listImportGroups, true),
+			id:           tenantID + "/name:Admins",
+			wantID:       groupID,
+			wantTenantID: tenantID,
+		},
+		{
+			name:     "not in the provider's tenant",
+			resource: newGroup(),
+			importer: importByName("group", listImportGroups, true),
+			id:       "name:Admins",
+			wantErr:  `no group named "Admins" was found`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := tt.resource.TestResourceData()
+			d.SetId(tt.id)
+
+			scoped := client.withTenantID(fakeDefaultTenantID)
+			got, err := tt.importer(ctx, d, scoped)
+			if tt.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+					t.Errorf("import error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("import error = %v", err)
+			}
+			if got[0].Id() != tt.wantID {
+				t.Errorf("Id = %s, want %s", got[0].Id(), tt.wantID)
+			}
+			if tt.wantTenantID != "" && got[0].Get("tenant_id") != tt.wantTenantID {
+				t.Errorf("tenant_id = %s, want %s", got[0].Get("tenant_id"), tt.wantTenantID)
+			}
+		})
+	}
+}
+
+func Test_importApplicationRole_byName(t *testing.T) {
+	fake, client := newFakeFusionAuthClient(t)
+
+	const roleID = "0b1a0b0e-5c3c-4f3b-8a33-7d0a4d8f2c11"
+	fake.seed("application", map[string]interface{}{
+		"id":       fakeDefaultApplicationID,
+		"name":     "FusionAuth",
+		"tenantId": fakeDefaultTenantID,
+		"roles":    []interface{}{map[string]interface{}{"id": roleID, "name": "admin"}},
+	})
+
+	d := newApplicationRole().TestResourceData()
+	d.SetId(fakeDefaultApplicationID + "/name:admin")
+	got, err := importApplicationRole(context.Background(), d, client)
+	if err != nil {
+		t.Fatalf("importApplicationRole() error = %v", err)
+	}
+	if got[0].Id() != roleID || got[0].Get("application_id") != fakeDefaultApplicationID {
+		t.Errorf("imported Id = %s, application_id = %s", got[0].Id(), got[0].Get("application_id"))
+	}
+}
+
+func Test_importEntity(t *testing.T) {
+	fake, client := newFakeFusionAuthClient(t)
+	ctx := context.Background()
+
+	const (
+		tenantID = "1d2f3a4b-5c6d-4e7f-8a9b-0c1d2e3f4a5b"
+		entityID = "6a7b8c9d-0e1f-4a2b-8c3d-4e5f6a7b8c9d"
+	)
+	fake.seed("tenant", map[string]interface{}{"id": tenantID, "name": "Other"})
+	fake.seed("entity", map[string]interface{}{"id": entityID, "name": "Raviga", "tenantId": tenantID})
+
+	tests := []struct {
+		name         string
+		client       Client
+		id           string
+		wantTenantID string
+		wantErr      string
+	}{
+		{name: "by id", client: client, id: entityID},
+		{name: "by name", client: client.withTenantID(tenantID), id: "name:Raviga"},
+		{name: "by tenant and id", client: client, id: tenantID + "/" + entityID, wantTenantID: tenantID},
+		{name: "by tenant and name", client: client, id: tenantID + "/name:Raviga", wantTenantID: tenantID},
+		{name: "legacy tenant and id", client: client, id: tenantID + ":" + entityID, wantTenantID: tenantID},
+		{name: "not in the provider's tenant", client: client.withTenantID(fakeDefaultTenantID), id: "name:Raviga", wantErr: `no entity named "Raviga" was found`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := resourceEntity().TestResourceData()
+			d.SetId(tt.id)
+
+			got, err := importEntity(ctx, d, tt.client)
+			if tt.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+					t.Errorf("importEntity() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("importEntity() error = %v", err)
+			}
+			if got[0].Id() != entityID {
+				t.Errorf("Id = %s, want %s", got[0].Id(), entityID)
+			}
+			if got[0].Get("name") != "Raviga" {
+				t.Errorf("name = %s, want Raviga", got[0].Get("name"))
+			}
+			if tt.wantTenantID != "" && got[0].Get("tenant_id") != tt.wantTenantID {
+				t.Errorf("tenant_id = %s, want %s", got[0].Get("tenant_id"), tt.wantTenantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package fusionauth
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redactedValue replaces the value of sensitive fields in logged bodies.
+const redactedValue = "***REDACTED***"
+
+// sensitiveFieldSuffixes are the endings of the JSON field names whose values
+// are never logged, e.g. clientSecret and httpAuthenticationPassword. Names
+// are compared case-insensitively with underscores removed, so both the
+// FusionAuth camelCase and the provider's snake_case spelling match.
+var sensitiveFieldSuffixes = []string{
+	"apikey",
+	"encryptionkey",
+	"license",
+	"password",
+	"privatekey",
+	"secret",
+	"secretkey",
+	"signingkey",
+	"token",
+}
+
+// sensitiveFields lists the field names that are sensitive although their
+// ending isn't, compared like sensitiveFieldSuffixes.
+var sensitiveFields = map[string]bool{
+	"key":       true,
+	"licenseid": true,
+}
+
+// traceLogEnvs are the environment variables setting the level of the
+// provider's logs, the most specific first.
+var traceLogEnvs = []string{"TF_LOG_PROVIDER_FUSIONAUTH", "TF_LOG_PROVIDER", "TF_LOG"}
+
+// loggingTransport logs every request made to the FusionAuth API, which covers
+// both the go-client and the provider's own raw requests. Method, path, status
+// and latency are logged at DEBUG, the JSON request and response bodies at
+// TRACE with the value of any sensitive field redacted. The Authorization
+// header is never logged.
+type loggingTransport struct {
+	transport http.RoundTripper
+	// traceBodies enables the logging of the bodies, which are only read
+	// into memory and redacted when it's set.
+	traceBodies bool
+}
+
+// traceLoggingEnabled reports whether the provider logs at TRACE, in which
+// case loggingTransport logs the bodies too.
+func traceLoggingEnabled() bool {
+	for _, env := range traceLogEnvs {
+		if level := os.Getenv(env); level != "" {
+			// JSON is Terraform's TRACE level in JSON format.
+			return strings.EqualFold(level, "TRACE") || strings.EqualFold(level, "JSON")
+		}
+	}
+	return false
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"http_path":   req.URL.Path,
+	}
+
+	if t.traceBodies && req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		tflog.Trace(ctx, "Sending FusionAuth API request body", withField(fields, "http_request_body", redactJSON(body)))
+	}
+
+	tflog.Debug(ctx, "Sending FusionAuth API request", fields)
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	fields = withField(fields, "http_duration_ms", time.Since(start).Milliseconds())
+	if err != nil {
+		tflog.Debug(ctx, "FusionAuth API request failed", withField(fields, "error", err.Error()))
+		return nil, err
+	}
+
+	fields = withField(fields, "http_status_code", resp.StatusCode)
+	tflog.Debug(ctx, "Received FusionAuth API response", fields)
+
+	if t.traceBodies && resp.Body != nil && resp.Body != http.NoBody {
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		tflog.Trace(ctx, "Received FusionAuth API response body", withField(fields, "http_response_body", redactJSON(body)))
+	}
+
+	return resp, nil
+}
+
+// withField returns a copy of fields with key set to value.
+func withField(fields map[string]interface{}, key string, value interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(fields)+1)
+	for k, v := range fields {
+		out[k] = v
+	}
+	out[key] = value
+
+	return out
+}
+
+// redactJSON returns the JSON body with the value of every sensitive field
+// replaced, at any depth. Bodies that aren't JSON are not logged, as there's
+// no telling what they contain.
+func redactJSON(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return "<non-JSON body omitted>"
+	}
+
+	redacted, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return "<body omitted>"
+	}
+
+	return string(redacted)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			switch value.(type) {
+			case map[string]interface{}, []interface{}:
+				// e.g. {"apiKey": {"key": "..."}}, only the secret itself
+				// is redacted, not the object holding it.
+				v[k] = redactValue(value)
+			default:
+				if value != nil && isSensitiveField(k) {
+					v[k] = redactedValue
+				}
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactValue(value)
+		}
+	}
+
+	return v
+}
+
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(strings.ReplaceAll(name, "_", ""))
+	if sensitiveFields[name] {
+		return true
+	}
+	for _, suffix := range sensitiveFieldSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+package fusionauth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func Test_redactJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "nested camelCase fields",
+			body: `{"application":{"name":"app","oauthConfiguration":{"clientId":"id","clientSecret":"s3cret"}}}`,
+			want: `{"application":{"name":"app","oauthConfiguration":{"clientId":"id","clientSecret":"***REDACTED***"}}}`,
+		},
+		{
+			name: "snake_case fields",
+			body: `{"client_secret":"s3cret","private_key":"pem","api_key":"k"}`,
+			want: `{"api_key":"***REDACTED***","client_secret":"***REDACTED***","private_key":"***REDACTED***"}`,
+		},
+		{
+			name: "objects holding secrets are kept",
+			body: `{"apiKey":{"id":"1","key":"k"},"users":[{"password":"p","email":"a@example.com"}]}`,
+			want: `{"apiKey":{"id":"1","key":"***REDACTED***"},"users":[{"email":"a@example.com","password":"***REDACTED***"}]}`,
+		},
+		{
+			name: "license",
+			body: `{"license":"abc","licenseId":"def"}`,
+			want: `{"license":"***REDACTED***","licenseId":"***REDACTED***"}`,
+		},
+		{
+			name: "nested passwords",
+			body: `{"webhook":{"url":"https://example.com","httpAuthenticationUsername":"u","httpAuthenticationPassword":"p"},"connector":{"systemAccountDN":"cn=admin","systemAccountPassword":"p"}}`,
+			want: `{"connector":{"systemAccountDN":"cn=admin","systemAccountPassword":"***REDACTED***"},"webhook":{"httpAuthenticationPassword":"***REDACTED***","httpAuthenticationUsername":"u","url":"https://example.com"}}`,
+		},
+		{
+			name: "secrets and tokens by their ending",
+			body: `{"messenger":{"http_authentication_password":"p","authToken":"t"},"identityProvider":{"consumerSecret":"s","applicationSecret":"s"},"tenant":{"emailConfiguration":{"password":"p","username":"u"}}}`,
+			want: `{"identityProvider":{"applicationSecret":"***REDACTED***","consumerSecret":"***REDACTED***"},"messenger":{"authToken":"***REDACTED***","http_authentication_password":"***REDACTED***"},"tenant":{"emailConfiguration":{"password":"***REDACTED***","username":"u"}}}`,
+		},
+		{
+			name: "not JSON",
+			body: `password=p`,
+			want: `<non-JSON body omitted>`,
+		},
+		{
+			name: "empty",
+			body: ``,
+			want: ``,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactJSON([]byte(tt.body)); got != tt.want {
+				t.Errorf("redactJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_loggingTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"apiKey":{"id":"1","key":"response-secret"}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	for _, traceBodies := range []bool{true, false} {
+		var out bytes.Buffer
+		ctx := tflogtest.RootLogger(context.Background(), &out)
+
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/api/api-key", strings.NewReader(`{"apiKey":{"key":"request-secret"}}`))
+		req.Header.Set("Authorization", "authorization-secret")
+
+		hc := &http.Client{Transport: &loggingTransport{transport: http.DefaultTransport, traceBodies: traceBodies}}
+		resp, err := hc.Do(req)
+		if err != nil {
+			t.Fatalf("request: %s", err)
+		}
+
+		var body bytes.Buffer
+		_, _ = body.ReadFrom(resp.Body)
+		_ = resp.Body.Close()
+		if !strings.Contains(body.String(), "response-secret") {
+			t.Errorf("response body was not passed through: %s", body.String())
+		}
+
+		logs := out.String()
+		for _, want := range []string{`"http_method":"POST"`, `"http_path":"/api/api-key"`, `"http_status_code":200`, `"http_duration_ms"`} {
+			if !strings.Contains(logs, want) {
+				t.Errorf("logs are missing %s:\n%s", want, logs)
+			}
+		}
+		for _, field := range []string{"http_request_body", "http_response_body"} {
+			if got := strings.Contains(logs, field); got != traceBodies {
+				t.Errorf("with traceBodies %t, logs contain %s: %t\n%s", traceBodies, field, got, logs)
+			}
+		}
+		for _, secret := range []string{"request-secret", "response-secret", "authorization-secret"} {
+			if strings.Contains(logs, secret) {
+				t.Errorf("logs contain %s:\n%s", secret, logs)
+			}
+		}
+	}
+}
+
+// failingBodyTransport returns responses whose body can't be read.
+type failingBodyTransport struct{}
+
+func (failingBodyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(iotest.ErrReader(errors.New("connection reset"))), Request: req}, nil
+}
+
+func Test_loggingTransport_responseBodyError(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/api/tenant", nil)
+	transport := &loggingTransport{transport: failingBodyTransport{}, traceBodies: true}
+	if _, err := transport.RoundTrip(req); err == nil || !strings.Contains(err.Error(), "connection reset") {
+		t.Errorf("RoundTrip error = %v, want the body's read error", err)
+	}
+}
+
+func Test_traceLoggingEnabled(t *testing.T) {
+	tests := []struct {
+		name string
+		envs map[string]string
+		want bool
+	}{
+		{name: "unset", envs: map[string]string{}, want: false},
+		{name: "TF_LOG trace", envs: map[string]string{"TF_LOG": "trace"}, want: true},
+		{name: "TF_LOG json", envs: map[string]string{"TF_LOG": "JSON"}, want: true},
+		{name: "TF_LOG debug", envs: map[string]string{"TF_LOG": "DEBUG"}, want: false},
+		{name: "provider overrides TF_LOG", envs: map[string]string{"TF_LOG": "TRACE", "TF_LOG_PROVIDER": "INFO"}, want: false},
+		{name: "this provider overrides the others", envs: map[string]string{"TF_LOG_PROVIDER": "INFO", "TF_LOG_PROVIDER_FUSIONAUTH": "TRACE"}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, env := range traceLogEnvs {
+				t.Setenv(env, tt.envs[env])
+			}
+			if got := traceLoggingEnabled(); got != tt.want {
+				t.Errorf("traceLoggingEnabled() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_isSensitiveField(t *testing.T) {
+	for name, want := range map[string]bool{
+		"httpAuthenticationPassword":   true,
+		"http_authentication_password": true,
+		"systemAccountPassword":        true,
+		"clientSecret":                 true,
+		"authToken":                    true,
+		"privateKey":                   true,
+		"license":                      true,
+		"licenseId":                    true,
+		"key":                          true,
+		"httpAuthenticationUsername":   false,
+		"publicKey":                    false,
+		"tokenEndpoint":                false,
+		"keyId":                        false,
+		"name":                         false,
+	} {
+		if got := isSensitiveField(name); got != want {
+			t.Errorf("isSensitiveField(%q) = %t, want %t", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package fusionauth
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+)
+
+// rawResponse captures the undecoded JSON body of a successful API response,
+// leaving it to the caller to unmarshal it into the appropriate type.
+type rawResponse struct {
+	fusionauth.BaseHTTPResponse
+	Body []byte
+}
+
+func (r *rawResponse) UnmarshalJSON(b []byte) error {
+	r.Body = append([]byte(nil), b...)
+	return nil
+}
+
+// doRawRequest sends a request to the FusionAuth API for endpoints or payloads
+// the go-client doesn't model. The request goes through the go-client's own
+// request pipeline, so it shares the provider's transport settings, retry
+// configuration, tenant scoping and structured error decoding with every other
+// API call.
+func doRawRequest(ctx context.Context, client Client, method, uri, segment string, body []byte) (*rawResponse, *fusionauth.Errors, error) {
+	var resp rawResponse
+	var faErrs fusionauth.Errors
+
+	restClient := client.FAClient.Start(&resp, &faErrs).
+		WithUri(uri).
+		WithUriSegment(segment).
+		WithMethod(method)
+	if body != nil {
+		restClient.WithJSONBody(json.RawMessage(body))
+	}
+
+	err := restClient.Do(ctx)
+	if err != nil && resp.StatusCode > 299 {
+		// The error body wasn't JSON (e.g. an HTML page from a proxy), so leave
+		// it to checkResponse to report the status code instead.
+		err = nil
+	}
+
+	if restClient.ErrorRef == nil || !faErrs.Present() {
+		return &resp, nil, err
+	}
+	return &resp, &faErrs, err
+}
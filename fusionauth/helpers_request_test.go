@@ -0,0 +1,111 @@
+package fusionauth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+)
+
+func newRawRequestTestClient(t *testing.T, handler http.HandlerFunc) Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	hostURL, _ := url.Parse(srv.URL)
+	retryConfig := fusionauth.NewBasicRetryConfiguration()
+	retryConfig.InitialDelay = time.Millisecond
+	retryConfig.Jitter = 0
+
+	faClient := fusionauth.NewClientWithRetryConfiguration(srv.Client(), hostURL, "api-key", retryConfig)
+	faClient.TenantId = "tenant"
+
+	return Client{FAClient: *faClient}
+}
+
+func Test_doRawRequest_retries(t *testing.T) {
+	var attempts int32
+	client := newRawRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "api-key" || r.Header.Get("X-FusionAuth-TenantId") != "tenant" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"identityProvider":{"id":"idp"}}`))
+	})
+
+	resp, faErrs, err := doRawRequest(context.Background(), client, http.MethodGet, "/api/identity-provider", "idp", nil)
+	if err != nil {
+		t.Fatalf("doRawRequest: %s", err)
+	}
+	if faErrs != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, errors = %v", resp.StatusCode, faErrs)
+	}
+	if string(resp.Body) != `{"identityProvider":{"id":"idp"}}` {
+		t.Errorf("body = %s", resp.Body)
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+func Test_doRawRequest_errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		wantFields int
+	}{
+		{
+			name:       "validation errors",
+			status:     http.StatusBadRequest,
+			body:       `{"fieldErrors":{"identityProvider.name":[{"code":"[blank]identityProvider.name","message":"You must specify the [identityProvider.name] property."}]}}`,
+			wantFields: 1,
+		},
+		{
+			name:   "not found",
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "non JSON body",
+			status: http.StatusBadRequest,
+			body:   "<html>Bad Request</html>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newRawRequestTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			})
+
+			resp, faErrs, err := doRawRequest(context.Background(), client, http.MethodPost, "/api/identity-provider", "", []byte(`{}`))
+			if err != nil {
+				t.Fatalf("doRawRequest: %s", err)
+			}
+			if resp.StatusCode != tt.status {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.status)
+			}
+
+			if tt.wantFields == 0 {
+				if faErrs != nil {
+					t.Errorf("errors = %v, want nil", faErrs)
+				}
+				return
+			}
+			if faErrs == nil || len(faErrs.FieldErrors) != tt.wantFields {
+				t.Errorf("errors = %v, want %d field errors", faErrs, tt.wantFields)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package fusionauth
 
 import (
+	"context"
 	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func Test_handleStringSliceFromList(t *testing.T) {
@@ -116,3 +119,69 @@ func Test_intMapToStringMap(t *testing.T) {
 		})
 	}
 }
+
+func Test_tenantScopedClient_crud(t *testing.T) {
+	const otherTenantID = "6f1b6f5e-58a4-4c1f-a5f1-3c4c79f5a1f2"
+
+	tests := []struct {
+		kind     string
+		id       string
+		resource *schema.Resource
+		config   map[string]interface{}
+	}{
+		{
+			kind:     "user",
+			id:       "3c9b1f0e-7d2a-4f5b-9e8c-1a2b3c4d5e01",
+			resource: newUser(),
+			config:   map[string]interface{}{"email": "richard@piedpiper.com"},
+		},
+		{
+			kind:     "group",
+			id:       "3c9b1f0e-7d2a-4f5b-9e8c-1a2b3c4d5e02",
+			resource: newGroup(),
+			config:   map[string]interface{}{"name": "Engineers"},
+		},
+		{
+			kind:     "application",
+			id:       "3c9b1f0e-7d2a-4f5b-9e8c-1a2b3c4d5e03",
+			resource: newApplication(),
+			config:   map[string]interface{}{"name": "Pied Piper"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			fake, client := newFakeFusionAuthClient(t)
+			ctx := context.Background()
+
+			// The provider is scoped to the default tenant, the resource
+			// belongs to another one.
+			client.FAClient.TenantId = fakeDefaultTenantID
+			fake.seed("tenant", map[string]interface{}{"id": otherTenantID, "name": "Other"})
+			obj := map[string]interface{}{"id": tt.id, "tenantId": otherTenantID}
+			for k, v := range tt.config {
+				obj[k] = v
+			}
+			fake.seed(tt.kind, obj)
+
+			tt.config["tenant_id"] = otherTenantID
+			data := schema.TestResourceDataRaw(t, tt.resource.Schema, tt.config)
+			data.SetId(tt.id)
+
+			if diags := tt.resource.ReadContext(ctx, data, client); diags.HasError() {
+				t.Fatalf("read: %v", diags)
+			}
+			if data.Id() != tt.id {
+				t.Fatalf("read removed the %s from the state", tt.kind)
+			}
+			if diags := tt.resource.UpdateContext(ctx, data, client); diags.HasError() {
+				t.Fatalf("update: %v", diags)
+			}
+			if diags := tt.resource.DeleteContext(ctx, data, client); diags.HasError() {
+				t.Fatalf("delete: %v", diags)
+			}
+			if _, ok := fake.object(tt.kind, tt.id); ok {
+				t.Errorf("the %s wasn't deleted", tt.kind)
+			}
+		})
+	}
+}
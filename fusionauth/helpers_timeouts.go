@@ -0,0 +1,95 @@
+package fusionauth
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultOperationTimeout is how long creating, updating or deleting a
+// resource may take when its timeouts block doesn't say otherwise.
+const defaultOperationTimeout = 5 * time.Minute
+
+// operationTimeoutKey marks a context whose deadline is the timeout of the
+// resource operation it belongs to.
+type operationTimeoutKey struct{}
+
+// addTimeouts adds a timeouts block for create, update and delete to the
+// resource. The plugin SDK bounds the context of each operation by its
+// timeout, and the context is marked so that HTTP requests made during the
+// operation run until that timeout instead of the provider's request_timeout.
+// Slow operations, e.g. copying a large tenant, then only fail when they
+// outlast the timeout the practitioner chose.
+func addTimeouts(r *schema.Resource) {
+	if r.Timeouts == nil {
+		r.Timeouts = &schema.ResourceTimeout{}
+	}
+
+	timeout := defaultOperationTimeout
+	if create := r.CreateContext; create != nil {
+		if r.Timeouts.Create == nil {
+			r.Timeouts.Create = &timeout
+		}
+		r.CreateContext = withOperationTimeout(create)
+	}
+	if update := r.UpdateContext; update != nil {
+		if r.Timeouts.Update == nil {
+			r.Timeouts.Update = &timeout
+		}
+		r.UpdateContext = withOperationTimeout(update)
+	}
+	if del := r.DeleteContext; del != nil {
+		if r.Timeouts.Delete == nil {
+			r.Timeouts.Delete = &timeout
+		}
+		r.DeleteContext = withOperationTimeout(del)
+	}
+}
+
+// withOperationTimeout marks the context f is called with as bounded by the
+// operation's timeout.
+func withOperationTimeout[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](f F) F {
+	return func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+		return f(context.WithValue(ctx, operationTimeoutKey{}, true), data, i)
+	}
+}
+
+// requestTimeoutTransport bounds each request by timeout, unless it is made
+// during a resource operation, which is bounded by the operation's timeout.
+type requestTimeoutTransport struct {
+	transport http.RoundTripper
+	timeout   time.Duration
+}
+
+func (t *requestTimeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Context().Value(operationTimeoutKey{}) != nil {
+		return t.transport.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The body is read after RoundTrip returns, so the timeout may only be
+	// released once it is closed.
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
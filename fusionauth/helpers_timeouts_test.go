@@ -0,0 +1,92 @@
+package fusionauth
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Test_addTimeouts(t *testing.T) {
+	for name, res := range Provider().ResourcesMap {
+		if res.Timeouts == nil || res.Timeouts.Create == nil || res.Timeouts.Delete == nil {
+			t.Errorf("%s: missing create or delete timeout", name)
+			continue
+		}
+		if (res.Timeouts.Update != nil) != (res.UpdateContext != nil) {
+			t.Errorf("%s: update timeout = %v, but the resource can't be updated", name, res.Timeouts.Update)
+		}
+		if _, ok := res.CoreConfigSchema().BlockTypes["timeouts"]; !ok {
+			t.Errorf("%s: missing timeouts block", name)
+		}
+	}
+
+	var marked bool
+	res := &schema.Resource{
+		CreateContext: func(ctx context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			marked = ctx.Value(operationTimeoutKey{}) != nil
+			return nil
+		},
+		Schema: map[string]*schema.Schema{},
+	}
+	addTimeouts(res)
+	_ = res.CreateContext(context.Background(), nil, nil)
+	if !marked {
+		t.Error("create wasn't called with a context marked with the operation timeout")
+	}
+}
+
+func Test_requestTimeoutTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		_, _ = w.Write([]byte("slow"))
+	}))
+	t.Cleanup(srv.Close)
+
+	client := &http.Client{Transport: &requestTimeoutTransport{
+		transport: http.DefaultTransport,
+		timeout:   20 * time.Millisecond,
+	}}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "request timeout",
+			ctx:     context.Background(),
+			wantErr: true,
+		},
+		{
+			name: "operation timeout",
+			ctx:  context.WithValue(context.Background(), operationTimeoutKey{}, true),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequestWithContext(tt.ctx, http.MethodGet, srv.URL, nil)
+			resp, err := client.Do(req)
+			if tt.wantErr {
+				if !errors.Is(err, context.DeadlineExceeded) {
+					t.Errorf("err = %v, want a deadline exceeded error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("err = %s", err)
+			}
+			defer resp.Body.Close()
+
+			if body, err := io.ReadAll(resp.Body); err != nil || string(body) != "slow" {
+				t.Errorf("body = %q, %v", body, err)
+			}
+		})
+	}
+}
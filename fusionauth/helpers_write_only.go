@@ -0,0 +1,80 @@
+package fusionauth
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// addWriteOnlySchema adds a write-only alternative to the sensitive attribute
+// key of schemas, named key_wo, whose value is sent to FusionAuth but never
+// stored in plan or state. As the value can't be diffed, changing key_wo_version
+// is what sends a new one. blockPath is the path of the block schemas belong
+// to, e.g. "email_configuration.0.", or "" for top level attributes.
+func addWriteOnlySchema(schemas map[string]*schema.Schema, key, blockPath string) {
+	s := schemas[key]
+	s.ConflictsWith = append(s.ConflictsWith, blockPath+key+"_wo")
+
+	schemas[key+"_wo"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ValidateFunc:  s.ValidateFunc,
+		ConflictsWith: []string{blockPath + key},
+		RequiredWith:  []string{blockPath + key + "_wo_version"},
+		Description:   "Write-only alternative to `" + key + "`, which isn't stored in state. Requires Terraform 1.11 or later. " + s.Description,
+	}
+	schemas[key+"_wo_version"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ForceNew:     s.ForceNew,
+		ValidateFunc: validation.IntAtLeast(1),
+		RequiredWith: []string{blockPath + key + "_wo"},
+		Description:  "The version of `" + key + "_wo`. Change it, e.g. by incrementing it, to send a new value of `" + key + "_wo` to FusionAuth.",
+	}
+}
+
+// sensitiveString returns the value of the sensitive attribute key, or of its
+// write-only alternative when that is set instead. Write-only values are only
+// available while the resource is created or updated.
+func sensitiveString(data *schema.ResourceData, key string) string {
+	if v := data.Get(key).(string); v != "" {
+		return v
+	}
+
+	v, diags := data.GetRawConfigAt(attributePath(key + "_wo"))
+	if diags.HasError() || !v.IsKnown() || v.IsNull() || !v.Type().Equals(cty.String) {
+		return ""
+	}
+
+	return v.AsString()
+}
+
+// usesWriteOnly reports whether the write-only alternative to the sensitive
+// attribute key is used, in which case the value FusionAuth returns mustn't be
+// read into state.
+// A schema without the write-only alternative, e.g. a data source's, never
+// uses it.
+func usesWriteOnly(data *schema.ResourceData, key string) bool {
+	version, ok := data.Get(key + "_wo_version").(int)
+	return ok && version > 0
+}
+
+// attributePath converts an attribute key, e.g.
+// "email_configuration.0.password", to its path.
+func attributePath(key string) cty.Path {
+	var path cty.Path
+	for _, step := range strings.Split(key, ".") {
+		if i, err := strconv.Atoi(step); err == nil {
+			path = path.IndexInt(i)
+			continue
+		}
+		path = path.GetAttr(step)
+	}
+
+	return path
+}
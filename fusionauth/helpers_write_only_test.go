@@ -0,0 +1,111 @@
+package fusionauth
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func Test_addWriteOnlySchema(t *testing.T) {
+	for name, res := range map[string]*schema.Resource{
+		"fusionauth_generic_messenger": newGenericMessenger(),
+		"fusionauth_imported_key":      resourceImportedKey(),
+		"fusionauth_reactor":           newReactor(),
+		"fusionauth_tenant":            newTenant(),
+		"fusionauth_user":              newUser(),
+		"fusionauth_webhook":           newWebhook(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := res.InternalValidate(nil, true); err != nil {
+				t.Errorf("InternalValidate() = %s", err)
+			}
+		})
+	}
+
+	email := newTenant().Schema["email_configuration"].Elem.(*schema.Resource).Schema
+	if got := email["password"].ConflictsWith; len(got) != 1 || got[0] != "email_configuration.0.password_wo" {
+		t.Errorf("password.ConflictsWith = %v", got)
+	}
+	if !email["password_wo"].WriteOnly || !email["password_wo"].Sensitive {
+		t.Errorf("password_wo isn't write-only and sensitive")
+	}
+
+	key := resourceImportedKey().Schema
+	if !key["secret_wo_version"].ForceNew {
+		t.Errorf("secret_wo_version isn't ForceNew, like secret")
+	}
+}
+
+func Test_sensitiveString(t *testing.T) {
+	res := newWebhook()
+	tests := []struct {
+		name       string
+		attributes map[string]string
+		config     map[string]cty.Value
+		want       string
+	}{
+		{
+			name:       "sensitive attribute",
+			attributes: map[string]string{"http_authentication_password": "plain"},
+			config:     map[string]cty.Value{"http_authentication_password": cty.StringVal("plain")},
+			want:       "plain",
+		},
+		{
+			name:       "write-only attribute",
+			attributes: map[string]string{"http_authentication_password_wo_version": "1"},
+			config: map[string]cty.Value{
+				"http_authentication_password_wo":         cty.StringVal("write-only"),
+				"http_authentication_password_wo_version": cty.NumberIntVal(1),
+			},
+			want: "write-only",
+		},
+		{
+			name: "neither",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := res.Data(&terraform.InstanceState{
+				ID:         "1",
+				Attributes: tt.attributes,
+				RawConfig:  testRawConfig(res, tt.config),
+			})
+			if got := sensitiveString(data, "http_authentication_password"); got != tt.want {
+				t.Errorf("sensitiveString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// Without a configuration, e.g. when reading state, there is no write-only
+	// value.
+	data := res.Data(&terraform.InstanceState{ID: "1"})
+	if got := sensitiveString(data, "http_authentication_password"); got != "" {
+		t.Errorf("sensitiveString() without configuration = %q", got)
+	}
+}
+
+// testRawConfig returns the configuration of a resource with the top level
+// attributes in values set.
+func testRawConfig(res *schema.Resource, values map[string]cty.Value) cty.Value {
+	attrs := map[string]cty.Value{}
+	for name, ty := range res.CoreConfigSchema().ImpliedType().AttributeTypes() {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+			continue
+		}
+		attrs[name] = cty.NullVal(ty)
+	}
+
+	return cty.ObjectVal(attrs)
+}
+
+func Test_attributePath(t *testing.T) {
+	want := cty.GetAttrPath("email_configuration").IndexInt(0).GetAttr("password_wo")
+	if got := attributePath("email_configuration.0.password_wo"); !got.Equals(want) {
+		t.Errorf("attributePath() = %#v, want %#v", got, want)
+	}
+}
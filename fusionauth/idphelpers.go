@@ -1,14 +1,10 @@
 package fusionauth
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"strings"
-	"time"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,11 +13,11 @@ import (
 
 const NotFoundError string = "404(Not Found)"
 
-func deleteIdentityProvider(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteIdentityProvider(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteIdentityProvider(id)
+	resp, faErrs, err := client.FAClient.DeleteIdentityProviderWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -33,116 +29,43 @@ func deleteIdentityProvider(_ context.Context, data *schema.ResourceData, i inte
 	return nil
 }
 
-func readIdentityProvider(id string, client Client) ([]byte, error) {
-	req, err := http.NewRequest(
-		http.MethodGet,
-		fmt.Sprintf("%s/%s/%s", strings.TrimRight(client.Host, "/"), "api/identity-provider", id),
-		nil,
-	)
-
+func readIdentityProvider(ctx context.Context, id string, client Client) ([]byte, error) {
+	resp, faErrs, err := doRawRequest(ctx, client, http.MethodGet, "/api/identity-provider", id, nil)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Authorization", client.APIKey)
-
-	hc := http.Client{
-		Timeout: 30 * time.Second,
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errors.New(NotFoundError)
 	}
-
-	resp, err := hc.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	if err := checkResponse(resp.StatusCode, nil); err != nil {
-		if resp.StatusCode == http.StatusNotFound {
-			return nil, errors.New(NotFoundError)
-		}
+	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
 		return nil, err
 	}
 
-	b, _ := io.ReadAll(resp.Body)
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, response: \n\t%s", resp.StatusCode, string(b))
-	}
-	return b, nil
+	return resp.Body, nil
 }
 
-func createIdentityProvider(b []byte, client Client, idpID string) ([]byte, error) {
-	var u string
-	if idpID != "" {
-		u = fmt.Sprintf("%s/%s/%s", strings.TrimRight(client.Host, "/"), "api/identity-provider", idpID)
-	} else {
-		u = fmt.Sprintf("%s/%s", strings.TrimRight(client.Host, "/"), "api/identity-provider")
-	}
-
-	req, err := http.NewRequest(
-		http.MethodPost,
-		u,
-		bytes.NewBuffer(b),
-	)
-
+func createIdentityProvider(ctx context.Context, b []byte, client Client, idpID string) ([]byte, error) {
+	resp, faErrs, err := doRawRequest(ctx, client, http.MethodPost, "/api/identity-provider", idpID, b)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Authorization", client.APIKey)
-	req.Header.Add("Content-Type", "application/json")
-
-	hc := http.Client{
-		Timeout: 30 * time.Second,
-	}
-
-	resp, err := hc.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	if err := checkResponse(resp.StatusCode, nil); err != nil {
+	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
 		return nil, err
 	}
-	bb, _ := io.ReadAll(resp.Body)
 
-	if resp.StatusCode > 299 {
-		return nil, fmt.Errorf("status: %d, response: \n\t%s\nreq body:\n\t%s", resp.StatusCode, string(bb), string(b))
-	}
-	return bb, nil
+	return resp.Body, nil
 }
 
-func updateIdentityProvider(b []byte, id string, client Client) ([]byte, error) {
-	req, err := http.NewRequest(
-		http.MethodPut,
-		fmt.Sprintf("%s/%s/%s", strings.TrimRight(client.Host, "/"), "api/identity-provider", id),
-		bytes.NewBuffer(b),
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Authorization", client.APIKey)
-	req.Header.Add("Content-Type", "application/json")
-
-	hc := http.Client{
-		Timeout: 30 * time.Second,
-	}
-
-	resp, err := hc.Do(req)
+func updateIdentityProvider(ctx context.Context, b []byte, id string, client Client) ([]byte, error) {
+	resp, faErrs, err := doRawRequest(ctx, client, http.MethodPut, "/api/identity-provider", id, b)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-	if err := checkResponse(resp.StatusCode, nil); err != nil {
+	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
 		return nil, err
 	}
-	bb, _ := io.ReadAll(resp.Body)
 
-	if resp.StatusCode > 299 {
-		return nil, fmt.Errorf("status: %d, response: \n\t%s\nreq body:\n\t%s", resp.StatusCode, string(bb), string(b))
-	}
-	return bb, nil
+	return resp.Body, nil
 }
 
 func buildTenantConfigurationResource(tcm map[string]fusionauth.IdentityProviderTenantConfiguration) []map[string]interface{} {
@@ -0,0 +1,104 @@
+package fusionauth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Test_identityProviderResources_roundTrip(t *testing.T) {
+	const applicationID = "8cbd4e1a-2bd6-4b3f-9d3f-6a9e4c6f1b2d"
+
+	tests := []struct {
+		resourceType string
+		wantType     string
+		config       map[string]interface{}
+	}{
+		{
+			resourceType: "fusionauth_idp_epic_games",
+			wantType:     "EpicGames",
+			config: map[string]interface{}{
+				"button_text":   "Login with Epic Games",
+				"client_id":     "epic-client",
+				"client_secret": "epic-secret",
+				"scope":         "basic_profile",
+			},
+		},
+		{
+			resourceType: "fusionauth_idp_hypr",
+			wantType:     "HYPR",
+			config: map[string]interface{}{
+				"relying_party_application_id": "FusionAuth",
+				"relying_party_url":            "https://example.hypr.com",
+			},
+		},
+		{
+			resourceType: "fusionauth_idp_nintendo",
+			wantType:     "Nintendo",
+			config: map[string]interface{}{
+				"button_text":     "Login with Nintendo",
+				"client_id":       "nintendo-client",
+				"client_secret":   "nintendo-secret",
+				"email_claim":     "email",
+				"unique_id_claim": "id",
+				"username_claim":  "nickname",
+			},
+		},
+		{
+			resourceType: "fusionauth_idp_twitter",
+			wantType:     "Twitter",
+			config: map[string]interface{}{
+				"button_text":     "Login with Twitter",
+				"consumer_key":    "twitter-key",
+				"consumer_secret": "twitter-secret",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.resourceType, func(t *testing.T) {
+			fake, client := newFakeFusionAuthClient(t)
+			ctx := context.Background()
+			res := Provider().ResourcesMap[tt.resourceType]
+
+			config := map[string]interface{}{
+				"enabled":          true,
+				"linking_strategy": "LinkByEmail",
+				"application_configuration": []interface{}{map[string]interface{}{
+					"application_id":      applicationID,
+					"create_registration": true,
+					"enabled":             true,
+				}},
+			}
+			for k, v := range tt.config {
+				config[k] = v
+			}
+
+			data := schema.TestResourceDataRaw(t, res.Schema, config)
+			if diags := res.CreateContext(ctx, data, client); diags.HasError() {
+				t.Fatalf("create: %v", diags)
+			}
+			stored, ok := fake.object("identityProvider", data.Id())
+			if !ok || stored["type"] != tt.wantType {
+				t.Fatalf("stored identity provider = %v, want type %s", stored, tt.wantType)
+			}
+
+			read := res.Data(nil)
+			read.SetId(data.Id())
+			if diags := res.ReadContext(ctx, read, client); diags.HasError() {
+				t.Fatalf("read: %v", diags)
+			}
+			for k, want := range config {
+				if k == "application_configuration" {
+					continue
+				}
+				if got := read.Get(k); got != want {
+					t.Errorf("%s = %v, want %v", k, got, want)
+				}
+			}
+			if n := read.Get("application_configuration").(*schema.Set).Len(); n != 1 {
+				t.Errorf("read %d application configurations, want 1", n)
+			}
+		})
+	}
+}
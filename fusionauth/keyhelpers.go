@@ -12,11 +12,11 @@ import (
 type keyReadFunc func(*schema.ResourceData, fusionauth.Key) diag.Diagnostics
 type keyBuildFunc func(*schema.ResourceData) fusionauth.Key
 
-func keyUpdate(data *schema.ResourceData, f keyBuildFunc, i interface{}) diag.Diagnostics {
+func keyUpdate(ctx context.Context, data *schema.ResourceData, f keyBuildFunc, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	l := f(data)
 
-	resp, faErrs, err := client.FAClient.UpdateKey(data.Id(), fusionauth.KeyRequest{Key: l})
+	resp, faErrs, err := client.FAClient.UpdateKeyWithContext(ctx, data.Id(), fusionauth.KeyRequest{Key: l})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -27,11 +27,11 @@ func keyUpdate(data *schema.ResourceData, f keyBuildFunc, i interface{}) diag.Di
 	return nil
 }
 
-func keyDelete(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func keyDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteKey(id)
+	resp, faErrs, err := client.FAClient.DeleteKeyWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -42,11 +42,11 @@ func keyDelete(_ context.Context, data *schema.ResourceData, i interface{}) diag
 	return nil
 }
 
-func keyRead(data *schema.ResourceData, f keyReadFunc, i interface{}) diag.Diagnostics {
+func keyRead(ctx context.Context, data *schema.ResourceData, f keyReadFunc, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.RetrieveKey(id)
+	resp, faErrs, err := client.FAClient.RetrieveKeyWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
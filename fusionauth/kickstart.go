@@ -0,0 +1,314 @@
+package fusionauth
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// kickstartFile is a FusionAuth kickstart.json.
+type kickstartFile struct {
+	Variables map[string]string  `json:"variables"`
+	APIKeys   []kickstartAPIKey  `json:"apiKeys"`
+	Requests  []kickstartRequest `json:"requests"`
+}
+
+type kickstartAPIKey struct {
+	Key         string `json:"key"`
+	Description string `json:"description"`
+}
+
+type kickstartRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Body   interface{} `json:"body"`
+}
+
+// kickstartResource describes how a resource type is turned into a kickstart
+// request, using the same builder the resource uses to create the object.
+type kickstartResource struct {
+	ResourceType string
+	Resource     func() *schema.Resource
+	// URI is the API the object is created with. Unless SingleInstance is
+	// set, the object's id is appended to it.
+	URI            string
+	SingleInstance bool
+	Body           func(data *schema.ResourceData) (interface{}, diag.Diagnostics)
+}
+
+// kickstartResources lists the supported resource types in the order their
+// requests are made, so objects are created before the objects that reference
+// them.
+var kickstartResources = []kickstartResource{
+	{
+		ResourceType:   "fusionauth_reactor",
+		Resource:       newReactor,
+		URI:            "/api/reactor",
+		SingleInstance: true,
+		Body: func(data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			return buildReactor(data), nil
+		},
+	},
+	{
+		ResourceType: "fusionauth_key",
+		Resource:     newKey,
+		URI:          "/api/key/generate",
+		Body: func(data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			return fusionauth.KeyRequest{Key: buildKey(data)}, nil
+		},
+	},
+	{
+		ResourceType: "fusionauth_lambda",
+		Resource:     newLambda,
+		URI:          "/api/lambda",
+		Body: func(data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			return fusionauth.LambdaRequest{Lambda: buildLambda(data)}, nil
+		},
+	},
+	{
+		ResourceType: "fusionauth_theme",
+		Resource:     newTheme,
+		URI:          "/api/theme",
+		Body: func(data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			// The state holds every template, so the theme doesn't need to
+			// be copied from its source_theme_id.
+			return fusionauth.ThemeRequest{Theme: buildTheme(data)}, nil
+		},
+	},
+	{
+		ResourceType: "fusionauth_tenant",
+		Resource:     newTenant,
+		URI:          "/api/tenant",
+		Body: func(data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			tenant, diags := buildTenant(data)
+			return fusionauth.TenantRequest{
+				Tenant:     tenant,
+				WebhookIds: handleStringSlice("webhook_ids", data),
+			}, diags
+		},
+	},
+	{
+		ResourceType: "fusionauth_group",
+		Resource:     newGroup,
+		URI:          "/api/group",
+		Body: func(data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			return buildGroup(data), nil
+		},
+	},
+	{
+		ResourceType: "fusionauth_application",
+		Resource:     newApplication,
+		URI:          "/api/application",
+		Body: func(data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			return fusionauth.ApplicationRequest{Application: buildApplication(data)}, nil
+		},
+	},
+	{
+		ResourceType: "fusionauth_user",
+		Resource:     newUser,
+		URI:          "/api/user",
+		Body: func(data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			return dataToUserRequest(data)
+		},
+	},
+}
+
+// stateResource is a resource in the JSON representation of Terraform state,
+// as output by `terraform show -json`.
+type stateResource struct {
+	Address string                 `json:"address"`
+	Mode    string                 `json:"mode"`
+	Type    string                 `json:"type"`
+	Values  map[string]interface{} `json:"values"`
+}
+
+type stateModule struct {
+	Resources    []stateResource `json:"resources"`
+	ChildModules []stateModule   `json:"child_modules"`
+}
+
+// Kickstart reads Terraform state in the JSON format output by
+// `terraform show -json` and writes a kickstart.json that creates the same
+// FusionAuth objects. Each object's id is a kickstart variable, which the
+// requests and any references to the object use.
+func Kickstart(r io.Reader, w io.Writer) error {
+	var state struct {
+		Values struct {
+			RootModule stateModule `json:"root_module"`
+		} `json:"values"`
+	}
+	if err := json.NewDecoder(r).Decode(&state); err != nil {
+		return fmt.Errorf("reading Terraform state: %w", err)
+	}
+
+	kickstart, err := buildKickstart(stateResources(state.Values.RootModule))
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(kickstart)
+}
+
+// stateResources flattens the managed resources of a module and its child
+// modules.
+func stateResources(module stateModule) []stateResource {
+	var resources []stateResource
+	for _, res := range module.Resources {
+		if res.Mode == "managed" {
+			resources = append(resources, res)
+		}
+	}
+	for _, child := range module.ChildModules {
+		resources = append(resources, stateResources(child)...)
+	}
+
+	return resources
+}
+
+func buildKickstart(resources []stateResource) (*kickstartFile, error) {
+	apiKey, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	kickstart := &kickstartFile{
+		Variables: map[string]string{"apiKey": apiKey},
+		APIKeys:   []kickstartAPIKey{{Key: "#{apiKey}", Description: "Kickstart API key"}},
+		Requests:  []kickstartRequest{},
+	}
+
+	// Name a variable for every object first, so references to objects
+	// created by later requests are resolved too.
+	idVariables := map[string]string{}
+	for _, res := range resources {
+		id, _ := res.Values["id"].(string)
+		if id == "" || !isKickstartResource(res.Type) {
+			continue
+		}
+		name := kickstartVariable(res.Address)
+		kickstart.Variables[name] = id
+		idVariables[id] = name
+	}
+
+	for _, kr := range kickstartResources {
+		for _, res := range resources {
+			if res.Type != kr.ResourceType {
+				continue
+			}
+
+			data, err := stateResourceData(kr.Resource(), res.Values)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", res.Address, err)
+			}
+
+			body, diags := kr.Body(data)
+			if diags.HasError() {
+				return nil, fmt.Errorf("%s: %w", res.Address, diagsError(diags))
+			}
+
+			uri := kr.URI
+			if name, ok := idVariables[data.Id()]; ok && !kr.SingleInstance {
+				uri += "/#{" + name + "}"
+			}
+
+			b, err := json.Marshal(body)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", res.Address, err)
+			}
+			var generic interface{}
+			if err := json.Unmarshal(b, &generic); err != nil {
+				return nil, fmt.Errorf("%s: %w", res.Address, err)
+			}
+
+			kickstart.Requests = append(kickstart.Requests, kickstartRequest{
+				Method: http.MethodPost,
+				URL:    uri,
+				Body:   replaceKickstartIDs(generic, idVariables),
+			})
+		}
+	}
+
+	return kickstart, nil
+}
+
+func isKickstartResource(resourceType string) bool {
+	for _, kr := range kickstartResources {
+		if kr.ResourceType == resourceType && !kr.SingleInstance {
+			return true
+		}
+	}
+	return false
+}
+
+// stateResourceData loads the attribute values of a resource from state into
+// resource data, so the resource's builder can read them.
+func stateResourceData(res *schema.Resource, values map[string]interface{}) (*schema.ResourceData, error) {
+	data := res.Data(nil)
+	if id, ok := values["id"].(string); ok {
+		data.SetId(id)
+	}
+
+	for k := range res.Schema {
+		v, ok := values[k]
+		if !ok || v == nil {
+			continue
+		}
+		if err := data.Set(k, v); err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+	}
+
+	return data, nil
+}
+
+// replaceKickstartIDs replaces every string that is the id of an object
+// created by the kickstart with a reference to its variable.
+func replaceKickstartIDs(v interface{}, idVariables map[string]string) interface{} {
+	switch v := v.(type) {
+	case string:
+		if name, ok := idVariables[v]; ok {
+			return "#{" + name + "}"
+		}
+	case map[string]interface{}:
+		for k, value := range v {
+			v[k] = replaceKickstartIDs(value, idVariables)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = replaceKickstartIDs(value, idVariables)
+		}
+	}
+
+	return v
+}
+
+// kickstartVariable returns the variable name for the id of the resource at
+// address, e.g. "fusionauth_tenant.dev" becomes "tenant_dev_id".
+func kickstartVariable(address string) string {
+	address = strings.ReplaceAll(address, "fusionauth_", "")
+
+	var b strings.Builder
+	underscore := false
+	for _, r := range address {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			underscore = false
+			continue
+		}
+		if !underscore {
+			b.WriteRune('_')
+			underscore = true
+		}
+	}
+
+	return strings.Trim(b.String(), "_") + "_id"
+}
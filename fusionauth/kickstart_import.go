@@ -0,0 +1,459 @@
+package fusionauth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/go-uuid"
+)
+
+// kickstartAPI maps the API of a kickstart request to the resource type that
+// manages the objects it creates.
+type kickstartAPI struct {
+	URI          string
+	ResourceType string
+	// Singular is the name the object is wrapped in, e.g. "tenant".
+	Singular string
+	// ReadURI is the API the object is retrieved from, when it differs from
+	// URI.
+	ReadURI string
+}
+
+var kickstartAPIs = []kickstartAPI{
+	{URI: "/api/tenant", ResourceType: "fusionauth_tenant", Singular: "tenant"},
+	{URI: "/api/application", ResourceType: "fusionauth_application", Singular: "application"},
+	{URI: "/api/lambda", ResourceType: "fusionauth_lambda", Singular: "lambda"},
+	{URI: "/api/key/generate", ResourceType: "fusionauth_key", Singular: "key", ReadURI: "/api/key"},
+	{URI: "/api/key/import", ResourceType: "fusionauth_imported_key", Singular: "key", ReadURI: "/api/key"},
+	{URI: "/api/theme", ResourceType: "fusionauth_theme", Singular: "theme"},
+	{URI: "/api/group", ResourceType: "fusionauth_group", Singular: "group"},
+	{URI: "/api/user", ResourceType: "fusionauth_user", Singular: "user"},
+	{URI: "/api/webhook", ResourceType: "fusionauth_webhook", Singular: "webhook"},
+	// The resource type depends on the identity provider's type.
+	{URI: "/api/identity-provider", Singular: "identityProvider"},
+}
+
+// kickstartTenantScoped lists the objects that belong to the tenant a kickstart
+// request is made for.
+var kickstartTenantScoped = map[string]bool{
+	"application": true,
+	"group":       true,
+	"user":        true,
+}
+
+var kickstartReplacement = regexp.MustCompile(`#\{([^}]+)\}`)
+
+// ImportKickstart reads a FusionAuth kickstart.json and writes a resource block
+// for every object its requests create, with the provider's attribute names.
+// Kickstart variables are resolved, and @{file} includes are read relative to
+// dir. Objects with an id set in the kickstart file also get an import block,
+// for instances the kickstart has already run against. Requests for APIs the
+// provider doesn't manage are listed in a comment.
+func ImportKickstart(ctx context.Context, r io.Reader, dir string, w io.Writer) error {
+	var kickstart struct {
+		Variables map[string]interface{} `json:"variables"`
+		Requests  []json.RawMessage      `json:"requests"`
+	}
+	if err := json.NewDecoder(r).Decode(&kickstart); err != nil {
+		return fmt.Errorf("reading kickstart: %w", err)
+	}
+
+	variables := make(map[string]string, len(kickstart.Variables))
+	for name, v := range kickstart.Variables {
+		s, ok := v.(string)
+		if !ok {
+			s = fmt.Sprint(v)
+		}
+		variables[name] = resolveKickstartString(s, nil)
+	}
+
+	// The objects are served to the resources' read functions, so the
+	// attributes are mapped exactly as they are when read from FusionAuth.
+	objects := map[string]map[string]interface{}{}
+	var exported []exportObject
+	var exportedPaths []string
+	var skipped []string
+
+	for _, raw := range kickstart.Requests {
+		var req struct {
+			Method   string          `json:"method"`
+			URL      string          `json:"url"`
+			TenantID string          `json:"tenantId"`
+			Body     json.RawMessage `json:"body"`
+		}
+		resolved := resolveKickstartString(string(raw), variables)
+		if err := json.Unmarshal([]byte(resolved), &req); err != nil {
+			return fmt.Errorf("reading kickstart request: %w", err)
+		}
+
+		body, err := kickstartRequestBody(req.Body, dir, variables)
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", req.Method, req.URL, err)
+		}
+
+		api, id, ok := matchKickstartAPI(req.URL)
+		obj, _ := body[api.Singular].(map[string]interface{})
+		method := strings.ToUpper(req.Method)
+		if !ok || obj == nil || (method != http.MethodPost && method != http.MethodPut && method != http.MethodPatch) {
+			skipped = append(skipped, fmt.Sprintf("%s %s", method, req.URL))
+			continue
+		}
+
+		if id == "" {
+			id, _ = obj["id"].(string)
+		}
+		known := id != ""
+		if !known {
+			id, _ = uuid.GenerateUUID()
+		}
+		obj["id"] = id
+		if kickstartTenantScoped[api.Singular] && obj["tenantId"] == nil && req.TenantID != "" {
+			obj["tenantId"] = req.TenantID
+		}
+
+		readURI := api.ReadURI
+		if readURI == "" {
+			readURI = api.URI
+		}
+		path := readURI + "/" + id
+
+		if existing, ok := objects[path]; ok {
+			// Later requests update objects created by earlier ones.
+			if method == http.MethodPatch {
+				obj = mergeJSON(existing, obj)
+			}
+			objects[path] = obj
+			continue
+		}
+		objects[path] = obj
+
+		resourceType := api.ResourceType
+		if api.Singular == "identityProvider" {
+			idpType, _ := obj["type"].(string)
+			if resourceType = identityProviderResourceTypes[idpType]; resourceType == "" {
+				skipped = append(skipped, fmt.Sprintf("%s %s", method, req.URL))
+				delete(objects, path)
+				continue
+			}
+		}
+
+		exported = append(exported, exportObject{
+			ResourceType: resourceType,
+			ID:           id,
+			NoImport:     !known,
+			// Only the fields a request sets are known, the rest are left
+			// to the attributes' defaults.
+			Sparse: true,
+		})
+		exportedPaths = append(exportedPaths, path)
+	}
+
+	// Objects are named once every update to them has been applied.
+	for i, path := range exportedPaths {
+		exported[i].Name = kickstartObjectName(objects[path])
+	}
+
+	// An application's roles are managed by their own resources.
+	for i, path := range exportedPaths {
+		if exported[i].ResourceType == "fusionauth_application" {
+			exported = append(exported, kickstartApplicationRoles(exported[i], objects[path])...)
+		}
+	}
+
+	if len(skipped) > 0 {
+		var b strings.Builder
+		b.WriteString("# The following kickstart requests aren't supported and were skipped:\n")
+		for _, s := range skipped {
+			fmt.Fprintf(&b, "#   %s\n", s)
+		}
+		b.WriteString("\n")
+		if _, err := io.WriteString(w, b.String()); err != nil {
+			return err
+		}
+	}
+
+	client := newKickstartClient(objects)
+	baseline := newKickstartClient(changeKickstartObjects(objects))
+	return writeExportedResources(ctx, Provider(), client, &baseline, exported, w)
+}
+
+// kickstartApplicationRoles returns the roles an application is created with.
+// Roles without an id are given one, which can't be imported.
+func kickstartApplicationRoles(app exportObject, obj map[string]interface{}) []exportObject {
+	roles, _ := obj["roles"].([]interface{})
+
+	objects := make([]exportObject, 0, len(roles))
+	for _, r := range roles {
+		role, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		id, _ := role["id"].(string)
+		known := id != ""
+		if !known {
+			id, _ = uuid.GenerateUUID()
+			role["id"] = id
+		}
+		name, _ := role["name"].(string)
+
+		objects = append(objects, exportObject{
+			ResourceType: "fusionauth_application_role",
+			ID:           id,
+			Name:         app.Name + " " + name,
+			NoImport:     app.NoImport || !known,
+			ImportID:     app.ID + "/" + id,
+			Attributes:   map[string]interface{}{"application_id": app.ID},
+			Sparse:       true,
+		})
+	}
+	return objects
+}
+
+// changeKickstartObjects returns a copy of objects with the value of every
+// field that was set changed, e.g. false to true, except for ids, which
+// objects are looked up by.
+func changeKickstartObjects(objects map[string]map[string]interface{}) map[string]map[string]interface{} {
+	changed := make(map[string]map[string]interface{}, len(objects))
+	for path, obj := range objects {
+		changed[path] = changeKickstartValue(obj).(map[string]interface{})
+	}
+	return changed
+}
+
+func changeKickstartValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case bool:
+		return !v
+	case float64:
+		return v + 1
+	case string:
+		return v + "~"
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, value := range v {
+			if k == "id" {
+				out[k] = value
+				continue
+			}
+			out[k] = changeKickstartValue(value)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, value := range v {
+			out[i] = changeKickstartValue(value)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// resolveKickstartString replaces kickstart variables, #{ENV.NAME} environment
+// variables and #{UUID()} in s. Unknown variables are left in place.
+func resolveKickstartString(s string, variables map[string]string) string {
+	return kickstartReplacement.ReplaceAllStringFunc(s, func(m string) string {
+		name := m[2 : len(m)-1]
+
+		var value string
+		switch {
+		case name == "UUID()":
+			value, _ = uuid.GenerateUUID()
+		case strings.HasPrefix(name, "ENV."):
+			v, ok := os.LookupEnv(strings.TrimPrefix(name, "ENV."))
+			if !ok {
+				return m
+			}
+			value = v
+		default:
+			v, ok := variables[name]
+			if !ok {
+				return m
+			}
+			value = v
+		}
+
+		// The replacement is made within a JSON string.
+		b, _ := json.Marshal(value)
+		return string(b[1 : len(b)-1])
+	})
+}
+
+// kickstartRequestBody decodes a request body, which kickstart also allows to
+// be included from a file with "@{path}".
+func kickstartRequestBody(raw json.RawMessage, dir string, variables map[string]string) (map[string]interface{}, error) {
+	var include string
+	if err := json.Unmarshal(raw, &include); err == nil {
+		if !strings.HasPrefix(include, "@{") || !strings.HasSuffix(include, "}") {
+			return nil, fmt.Errorf("unexpected body %q", include)
+		}
+		b, err := os.ReadFile(filepath.Join(dir, include[2:len(include)-1]))
+		if err != nil {
+			return nil, err
+		}
+		raw = []byte(resolveKickstartString(string(b), variables))
+	}
+
+	var body map[string]interface{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &body); err != nil {
+			return nil, err
+		}
+	}
+
+	return includeKickstartFiles(body, dir).(map[string]interface{}), nil
+}
+
+// includeKickstartFiles replaces "@{path}" strings, e.g. a lambda's body, with
+// the content of the file.
+func includeKickstartFiles(v interface{}, dir string) interface{} {
+	switch v := v.(type) {
+	case string:
+		if strings.HasPrefix(v, "@{") && strings.HasSuffix(v, "}") {
+			if b, err := os.ReadFile(filepath.Join(dir, v[2:len(v)-1])); err == nil {
+				return string(b)
+			}
+		}
+	case map[string]interface{}:
+		for k, value := range v {
+			v[k] = includeKickstartFiles(value, dir)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = includeKickstartFiles(value, dir)
+		}
+	}
+
+	return v
+}
+
+// matchKickstartAPI finds the API a request URL is for, and the id of the
+// object in the URL, if any.
+func matchKickstartAPI(rawURL string) (api kickstartAPI, id string, ok bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return api, "", false
+	}
+	path := strings.TrimSuffix(u.Path, "/")
+
+	for _, api := range kickstartAPIs {
+		if path == api.URI {
+			return api, "", true
+		}
+		// FusionAuth ids are UUIDs, which tells them apart from other APIs
+		// under the same path, e.g. /api/user/registration.
+		rest := strings.TrimPrefix(path, api.URI+"/")
+		if _, err := uuid.ParseUUID(rest); rest != path && err == nil {
+			return api, rest, true
+		}
+	}
+
+	return api, "", false
+}
+
+// kickstartObjectName returns the name a resource for obj is named after.
+func kickstartObjectName(obj map[string]interface{}) string {
+	for _, field := range []string{"name", "username", "email"} {
+		if name, _ := obj[field].(string); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// newKickstartClient returns a client that serves objects from memory, keyed
+// by the path they are retrieved from, instead of calling FusionAuth.
+func newKickstartClient(objects map[string]map[string]interface{}) Client {
+	baseURL := &url.URL{Scheme: "http", Host: "kickstart"}
+	httpClient := &http.Client{Transport: kickstartTransport(objects)}
+
+	return Client{FAClient: *fusionauth.NewClient(httpClient, baseURL, "")}
+}
+
+type kickstartTransport map[string]map[string]interface{}
+
+func (t kickstartTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       http.NoBody,
+		Request:    req,
+	}
+
+	obj, ok := t[req.URL.Path]
+	if !ok || req.Method != http.MethodGet {
+		return resp, nil
+	}
+
+	var singular string
+	for _, api := range kickstartAPIs {
+		readURI := api.ReadURI
+		if readURI == "" {
+			readURI = api.URI
+		}
+		if strings.HasPrefix(req.URL.Path, readURI+"/") {
+			singular = api.Singular
+			break
+		}
+	}
+
+	b, err := json.Marshal(map[string]interface{}{singular: obj})
+	if err != nil {
+		return nil, err
+	}
+
+	resp.StatusCode = http.StatusOK
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+	return resp, nil
+}
+
+// mergeJSON merges patch into dst following JSON merge patch semantics, where
+// a null value removes the field.
+func mergeJSON(dst, patch map[string]interface{}) map[string]interface{} {
+	for k, v := range patch {
+		switch v := v.(type) {
+		case nil:
+			delete(dst, k)
+		case map[string]interface{}:
+			existing, _ := dst[k].(map[string]interface{})
+			if existing == nil {
+				existing = map[string]interface{}{}
+			}
+			dst[k] = mergeJSON(existing, v)
+		default:
+			dst[k] = v
+		}
+	}
+
+	return dst
+}
+
+func deepCopyJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, value := range v {
+			out[k] = deepCopyJSON(value)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, value := range v {
+			out[i] = deepCopyJSON(value)
+		}
+		return out
+	default:
+		return v
+	}
+}
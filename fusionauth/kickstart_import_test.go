@@ -0,0 +1,167 @@
+package fusionauth
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+const kickstartImportTestFile = `{
+  "variables": {
+    "tenantId": "a3a8e4f1-7c61-4e7a-b0b3-5c6c8d1f0a11",
+    "issuer": "dev.example.com"
+  },
+  "requests": [
+    {
+      "method": "POST",
+      "url": "/api/lambda",
+      "body": {
+        "lambda": {
+          "name": "Populate",
+          "type": "JWTPopulate",
+          "engineType": "GraalJS",
+          "body": "@{populate.js}"
+        }
+      }
+    },
+    {
+      "method": "POST",
+      "url": "/api/tenant/#{tenantId}",
+      "body": "@{tenant.json}"
+    },
+    {
+      "method": "PATCH",
+      "url": "/api/tenant/#{tenantId}",
+      "body": {
+        "tenant": {
+          "name": "Development"
+        }
+      }
+    },
+    {
+      "method": "POST",
+      "url": "/api/application/85a03867-dccf-4882-adde-1a79aeec50df",
+      "tenantId": "#{tenantId}",
+      "body": {
+        "application": {
+          "name": "Web",
+          "active": false,
+          "loginConfiguration": {
+            "requireAuthentication": false
+          },
+          "oauthConfiguration": {
+            "authorizedRedirectURLs": ["https://web.example.com/callback"],
+            "scopeHandlingPolicy": "Strict",
+            "unknownScopePolicy": "Reject"
+          },
+          "roles": [
+            {"id": "9c1e6a52-8a9b-4b5d-a0c1-2f3e4d5c6b7a", "name": "admin", "isSuperRole": true},
+            {"name": "user", "isDefault": true}
+          ]
+        }
+      }
+    },
+    {
+      "method": "POST",
+      "url": "/api/application/85a03867-dccf-4882-adde-1a79aeec50df/role",
+      "body": {
+        "role": {
+          "name": "admin"
+        }
+      }
+    }
+  ]
+}`
+
+func Test_ImportKickstart(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"populate.js": "function populate(jwt, user, registration) {}",
+		"tenant.json": `{"tenant": {"name": "Dev", "issuer": "#{issuer}"}}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := ImportKickstart(context.Background(), strings.NewReader(kickstartImportTestFile), dir, &out); err != nil {
+		t.Fatalf("ImportKickstart: %s", err)
+	}
+
+	if _, diags := hclwrite.ParseConfig(out.Bytes(), "kickstart.tf", hcl.InitialPos); diags.HasErrors() {
+		t.Fatalf("output isn't valid HCL: %s\n%s", diags, out.String())
+	}
+
+	// hclwrite aligns the equals signs of attributes.
+	got := strings.Join(strings.Fields(out.String()), " ")
+	for _, want := range []string{
+		`# POST /api/application/85a03867-dccf-4882-adde-1a79aeec50df/role`,
+		`resource "fusionauth_lambda" "populate" {`,
+		`body = "function populate(jwt, user, registration) {}"`,
+		`to = fusionauth_tenant.development id = "a3a8e4f1-7c61-4e7a-b0b3-5c6c8d1f0a11"`,
+		`resource "fusionauth_tenant" "development" {`,
+		`issuer = "dev.example.com"`,
+		`to = fusionauth_application.web id = "85a03867-dccf-4882-adde-1a79aeec50df"`,
+		`tenant_id = fusionauth_tenant.development.id`,
+		// Values that were set are written even when they're the zero
+		// value, and the attribute's default differs.
+		`resource "fusionauth_application" "web" { active = false`,
+		`require_authentication = false`,
+		`scope_handling_policy = "Strict"`,
+		`to = fusionauth_application_role.web_admin id = "85a03867-dccf-4882-adde-1a79aeec50df/9c1e6a52-8a9b-4b5d-a0c1-2f3e4d5c6b7a"`,
+		`resource "fusionauth_application_role" "web_admin" { application_id = fusionauth_application.web.id is_super_role = true name = "admin" }`,
+		`resource "fusionauth_application_role" "web_user" { application_id = fusionauth_application.web.id is_default = true name = "user" }`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output is missing %s:\n%s", want, out.String())
+		}
+	}
+
+	// The lambda's and the user role's ids are generated by FusionAuth, so
+	// they can't be imported.
+	for _, unwanted := range []string{
+		"to = fusionauth_lambda.populate",
+		"to = fusionauth_application_role.web_user",
+		// Blocks the application wasn't created with, which would only
+		// hold blank required attributes.
+		"samlv2_configuration",
+		"jwt_configuration",
+		`= ""`,
+	} {
+		if strings.Contains(got, unwanted) {
+			t.Errorf("output contains %s:\n%s", unwanted, out.String())
+		}
+	}
+}
+
+func Test_matchKickstartAPI(t *testing.T) {
+	tests := []struct {
+		url        string
+		wantSingle string
+		wantID     string
+		wantOK     bool
+	}{
+		{url: "/api/tenant", wantSingle: "tenant", wantOK: true},
+		{url: "/api/tenant/a3a8e4f1-7c61-4e7a-b0b3-5c6c8d1f0a11", wantSingle: "tenant", wantID: "a3a8e4f1-7c61-4e7a-b0b3-5c6c8d1f0a11", wantOK: true},
+		{url: "/api/key/generate/a3a8e4f1-7c61-4e7a-b0b3-5c6c8d1f0a11", wantSingle: "key", wantID: "a3a8e4f1-7c61-4e7a-b0b3-5c6c8d1f0a11", wantOK: true},
+		{url: "/api/user/registration", wantOK: false},
+		{url: "/api/system-configuration", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			api, id, ok := matchKickstartAPI(tt.url)
+			if ok != tt.wantOK || id != tt.wantID || (ok && api.Singular != tt.wantSingle) {
+				t.Errorf("matchKickstartAPI() = %s, %q, %v, want %s, %q, %v", api.Singular, id, ok, tt.wantSingle, tt.wantID, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package fusionauth
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const kickstartTestState = `{
+  "format_version": "1.0",
+  "values": {
+    "root_module": {
+      "resources": [
+        {
+          "address": "fusionauth_application.web",
+          "mode": "managed",
+          "type": "fusionauth_application",
+          "name": "web",
+          "values": {
+            "id": "85a03867-dccf-4882-adde-1a79aeec50df",
+            "name": "Web",
+            "tenant_id": "a3a8e4f1-7c61-4e7a-b0b3-5c6c8d1f0a11",
+            "lambda_configuration": [{"access_token_populate_id": "0f5e2a2b-55c4-4c3c-9f2e-0c7a1f2d2c31"}]
+          }
+        },
+        {
+          "address": "fusionauth_lambda.populate",
+          "mode": "managed",
+          "type": "fusionauth_lambda",
+          "name": "populate",
+          "values": {
+            "id": "0f5e2a2b-55c4-4c3c-9f2e-0c7a1f2d2c31",
+            "name": "Populate",
+            "type": "JWTPopulate",
+            "body": "function populate(jwt, user, registration) {}",
+            "engine_type": "GraalJS",
+            "debug": false
+          }
+        },
+        {
+          "address": "data.fusionauth_tenant.default",
+          "mode": "data",
+          "type": "fusionauth_tenant",
+          "name": "default",
+          "values": {"id": "e8f1d3c2-8a1b-4a8e-9f3f-0b1c2d3e4f50", "name": "Default"}
+        }
+      ],
+      "child_modules": [
+        {
+          "resources": [
+            {
+              "address": "module.identity.fusionauth_tenant.dev",
+              "mode": "managed",
+              "type": "fusionauth_tenant",
+              "name": "dev",
+              "values": {
+                "id": "a3a8e4f1-7c61-4e7a-b0b3-5c6c8d1f0a11",
+                "name": "Dev",
+                "issuer": "dev.example.com"
+              }
+            }
+          ]
+        }
+      ]
+    }
+  }
+}`
+
+func Test_Kickstart(t *testing.T) {
+	var out bytes.Buffer
+	if err := Kickstart(strings.NewReader(kickstartTestState), &out); err != nil {
+		t.Fatalf("Kickstart: %s", err)
+	}
+
+	var kickstart struct {
+		Variables map[string]string `json:"variables"`
+		APIKeys   []struct {
+			Key string `json:"key"`
+		} `json:"apiKeys"`
+		Requests []struct {
+			Method string                 `json:"method"`
+			URL    string                 `json:"url"`
+			Body   map[string]interface{} `json:"body"`
+		} `json:"requests"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &kickstart); err != nil {
+		t.Fatalf("kickstart.json isn't valid JSON: %s\n%s", err, out.String())
+	}
+
+	wantVariables := map[string]string{
+		"lambda_populate_id":            "0f5e2a2b-55c4-4c3c-9f2e-0c7a1f2d2c31",
+		"module_identity_tenant_dev_id": "a3a8e4f1-7c61-4e7a-b0b3-5c6c8d1f0a11",
+		"application_web_id":            "85a03867-dccf-4882-adde-1a79aeec50df",
+	}
+	for name, want := range wantVariables {
+		if got := kickstart.Variables[name]; got != want {
+			t.Errorf("variables.%s = %q, want %q", name, got, want)
+		}
+	}
+	if len(kickstart.APIKeys) != 1 || kickstart.APIKeys[0].Key != "#{apiKey}" || kickstart.Variables["apiKey"] == "" {
+		t.Errorf("apiKeys = %+v, apiKey variable = %q", kickstart.APIKeys, kickstart.Variables["apiKey"])
+	}
+
+	wantURLs := []string{
+		"/api/lambda/#{lambda_populate_id}",
+		"/api/tenant/#{module_identity_tenant_dev_id}",
+		"/api/application/#{application_web_id}",
+	}
+	if len(kickstart.Requests) != len(wantURLs) {
+		t.Fatalf("got %d requests, want %d:\n%s", len(kickstart.Requests), len(wantURLs), out.String())
+	}
+	for i, want := range wantURLs {
+		if got := kickstart.Requests[i]; got.Method != "POST" || got.URL != want {
+			t.Errorf("requests[%d] = %s %s, want POST %s", i, got.Method, got.URL, want)
+		}
+	}
+
+	tenant := kickstart.Requests[1].Body["tenant"].(map[string]interface{})
+	if tenant["name"] != "Dev" || tenant["issuer"] != "dev.example.com" {
+		t.Errorf("tenant body = %v", tenant)
+	}
+
+	app := kickstart.Requests[2].Body["application"].(map[string]interface{})
+	if app["tenantId"] != "#{module_identity_tenant_dev_id}" {
+		t.Errorf("application.tenantId = %v", app["tenantId"])
+	}
+	lambdaConfig := app["lambdaConfiguration"].(map[string]interface{})
+	if lambdaConfig["accessTokenPopulateId"] != "#{lambda_populate_id}" {
+		t.Errorf("application.lambdaConfiguration = %v", lambdaConfig)
+	}
+}
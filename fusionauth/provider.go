@@ -2,11 +2,12 @@ package fusionauth
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider configures and returns a fusionauth terraform provider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"host": {
 				Type:        schema.TypeString,
@@ -18,6 +19,76 @@ func Provider() *schema.Provider {
 				Required:    true,
 				DefaultFunc: schema.EnvDefaultFunc("FA_API_KEY", nil),
 			},
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("FA_TENANT_ID", nil),
+				ValidateFunc: validation.IsUUID,
+			},
+			"request_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("FA_REQUEST_TIMEOUT", 30),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("FA_MAX_RETRIES", -1),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_wait_min_ms": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_wait_max_ms": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30000,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"http_proxy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("FA_HTTP_PROXY", nil),
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			},
+			"ca_certificates": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"client_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("FA_CLIENT_CERTIFICATE", nil),
+				RequiredWith: []string{"client_key"},
+			},
+			"client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				DefaultFunc:  schema.EnvDefaultFunc("FA_CLIENT_KEY", nil),
+				RequiredWith: []string{"client_certificate"},
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FA_INSECURE_SKIP_VERIFY", false),
+			},
+			"wait_for_ready": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FA_WAIT_FOR_READY", false),
+			},
+			"ready_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("FA_READY_TIMEOUT", 300),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"fusionauth_api_key":                      resourceAPIKey(),
@@ -36,18 +107,23 @@ func Provider() *schema.Provider {
 			"fusionauth_form_field":                   resourceFormField(),
 			"fusionauth_group":                        newGroup(),
 			"fusionauth_idp_apple":                    resourceIDPApple(),
+			"fusionauth_idp_epic_games":               resourceIDPEpicGames(),
 			"fusionauth_idp_external_jwt":             resourceIDPExternalJWT(),
 			"fusionauth_idp_facebook":                 resourceIDPFacebook(),
 			"fusionauth_idp_google":                   newIDPGoogle(),
+			"fusionauth_idp_hypr":                     resourceIDPHYPR(),
 			"fusionauth_idp_linkedin":                 resourceIDPLinkedIn(),
+			"fusionauth_idp_nintendo":                 resourceIDPNintendo(),
 			"fusionauth_idp_open_id_connect":          newIDPOpenIDConnect(),
 			"fusionauth_idp_saml_v2":                  resourceIDPSAMLv2(),
 			"fusionauth_idp_saml_v2_idp_initated":     resourceIDPSAMLv2IdPInitiated(),
 			"fusionauth_idp_sony_psn":                 resourceIDPSonyPSN(),
 			"fusionauth_idp_steam":                    resourceIDPSteam(),
 			"fusionauth_idp_twitch":                   resourceIDPTwitch(),
+			"fusionauth_idp_twitter":                  resourceIDPTwitter(),
 			"fusionauth_idp_xbox":                     resourceIDPXbox(),
 			"fusionauth_imported_key":                 resourceImportedKey(),
+			"fusionauth_ip_access_control_list":       newIPAccessControlList(),
 			"fusionauth_key":                          newKey(),
 			"fusionauth_lambda":                       newLambda(),
 			"fusionauth_ldap_connector":               newLDAPConnector(),
@@ -66,24 +142,46 @@ func Provider() *schema.Provider {
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"fusionauth_application":             dataSourceApplication(),
+			"fusionauth_applications":            dataSourceApplications(),
 			"fusionauth_application_oauth_scope": dataSourceApplicationOAuthScope(),
 			"fusionauth_application_role":        dataSourceApplicationRole(),
 			"fusionauth_consent":                 dataSourceConsent(),
 			"fusionauth_email":                   dataSourceEmail(),
+			"fusionauth_email_templates":         dataSourceEmailTemplates(),
+			"fusionauth_entity":                  dataSourceEntity(),
+			"fusionauth_entity_type":             dataSourceEntityType(),
+			"fusionauth_entity_type_permission":  dataSourceEntityTypePermission(),
 			"fusionauth_form":                    dataSourceForm(),
 			"fusionauth_form_field":              dataSourceFormField(),
 			"fusionauth_generic_connector":       dataSourceGenericConnector(),
 			"fusionauth_generic_messenger":       dataSourceGenericMessenger(),
+			"fusionauth_group":                   dataSourceGroup(),
+			"fusionauth_groups":                  dataSourceGroups(),
 			"fusionauth_idp":                     dataSourceIDP(),
+			"fusionauth_idps":                    dataSourceIDPs(),
+			"fusionauth_ip_access_control_list":  dataSourceIPAccessControlList(),
+			"fusionauth_keys":                    dataSourceKeys(),
 			"fusionauth_lambda":                  dataSourceLambda(),
+			"fusionauth_lambdas":                 dataSourceLambdas(),
 			"fusionauth_ldap_connector":          dataSourceLDAPConnector(),
 			"fusionauth_sms_message_template":    dataSourceSMSMessageTemplate(),
+			"fusionauth_server_info":             dataSourceServerInfo(),
 			"fusionauth_tenant":                  dataSourceTenant(),
+			"fusionauth_tenants":                 dataSourceTenants(),
 			"fusionauth_theme":                   dataSourceTheme(),
+			"fusionauth_themes":                  dataSourceThemes(),
 			"fusionauth_twilio_messenger":        dataSourceTwilioMessenger(),
 			"fusionauth_user":                    dataSourceUser(),
 			"fusionauth_user_group_membership":   dataSourceUserGroupMembership(),
+			"fusionauth_users":                   dataSourceUsers(),
+			"fusionauth_webhooks":                dataSourceWebhooks(),
 		},
 		ConfigureContextFunc: configureClient,
 	}
+
+	for _, r := range p.ResourcesMap {
+		addTimeouts(r)
+	}
+
+	return p
 }
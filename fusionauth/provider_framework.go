@@ -0,0 +1,124 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProviderServer returns the provider server, which serves the plugin SDK
+// provider together with the framework provider for the features the SDK
+// doesn't support, such as ephemeral resources.
+func ProviderServer(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	sdkProvider := Provider()
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(&frameworkProvider{sdkProvider: sdkProvider}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
+
+// frameworkProvider serves the ephemeral resources. It shares the provider
+// configuration, and the client configured from it, with the SDK provider.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+var _ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "fusionauth"
+}
+
+// Schema mirrors the SDK provider's schema, which the mux server requires to
+// be the same for every provider it serves.
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	block := schema.InternalMap(p.sdkProvider.Schema).CoreConfigSchema()
+
+	attributes := make(map[string]providerschema.Attribute, len(block.Attributes))
+	for name, a := range block.Attributes {
+		switch {
+		case a.Type.Equals(cty.String):
+			attributes[name] = providerschema.StringAttribute{Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive, Description: a.Description}
+		case a.Type.Equals(cty.Number):
+			attributes[name] = providerschema.Int64Attribute{Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive, Description: a.Description}
+		case a.Type.Equals(cty.Bool):
+			attributes[name] = providerschema.BoolAttribute{Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive, Description: a.Description}
+		case a.Type.Equals(cty.List(cty.String)):
+			attributes[name] = providerschema.ListAttribute{ElementType: types.StringType, Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive, Description: a.Description}
+		}
+	}
+
+	resp.Schema = providerschema.Schema{Attributes: attributes}
+}
+
+// Configure passes the SDK provider on to the ephemeral resources. The mux
+// server configures the SDK provider first, so its client is ready by the
+// time an ephemeral resource is opened.
+func (p *frameworkProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	resp.EphemeralResourceData = p.sdkProvider
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newEphemeralAPIKey,
+		newEphemeralApplicationCredentials,
+	}
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return nil
+}
+
+// configureEphemeralResource returns the SDK provider passed on by the
+// framework provider's Configure, which is nil until the provider is
+// configured.
+func configureEphemeralResource(req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) *schema.Provider {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	sdkProvider, ok := req.ProviderData.(*schema.Provider)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expected *schema.Provider, got %T.", req.ProviderData))
+	}
+
+	return sdkProvider
+}
+
+// ephemeralClient returns the client the SDK provider was configured with.
+func ephemeralClient(sdkProvider *schema.Provider) (Client, fwdiag.Diagnostics) {
+	var diags fwdiag.Diagnostics
+
+	if sdkProvider == nil {
+		diags.AddError("Provider not configured", "The provider must be configured before ephemeral resources are opened.")
+		return Client{}, diags
+	}
+	client, ok := sdkProvider.Meta().(Client)
+	if !ok {
+		diags.AddError("Provider not configured", "The provider must be configured before ephemeral resources are opened.")
+	}
+
+	return client, diags
+}
@@ -0,0 +1,257 @@
+package fusionauth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func Test_ProviderServer(t *testing.T) {
+	for name, domain := range map[string]string{
+		"without environment": "",
+		"with environment":    "http://localhost:9011",
+	} {
+		t.Run(name, func(t *testing.T) {
+			// Whether host is required depends on the environment, which
+			// both providers' schemas must agree on.
+			t.Setenv("FA_DOMAIN", domain)
+
+			server := testProviderServer(t)
+			resp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+			if err != nil {
+				t.Fatalf("GetProviderSchema: %s", err)
+			}
+			for _, d := range resp.Diagnostics {
+				t.Errorf("GetProviderSchema: %s: %s", d.Summary, d.Detail)
+			}
+
+			for _, name := range []string{"fusionauth_api_key", "fusionauth_application_credentials"} {
+				if _, ok := resp.EphemeralResourceSchemas[name]; !ok {
+					t.Errorf("ephemeral resource %s is missing", name)
+				}
+			}
+			if _, ok := resp.ResourceSchemas["fusionauth_tenant"]; !ok {
+				t.Errorf("SDK resources are missing")
+			}
+		})
+	}
+}
+
+func Test_ephemeralAPIKey(t *testing.T) {
+	ctx := context.Background()
+	fake, client := newFakeFusionAuthClient(t)
+	server := testConfiguredProviderServer(t, client)
+
+	openResp, err := server.OpenEphemeralResource(ctx, &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "fusionauth_api_key",
+		Config: testEphemeralConfig(t, server, "fusionauth_api_key", map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "ci"),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("OpenEphemeralResource: %s", err)
+	}
+	testNoDiagnostics(t, openResp.Diagnostics)
+
+	result := testEphemeralResult(t, server, "fusionauth_api_key", openResp.Result)
+	var id, key string
+	_ = result["id"].As(&id)
+	_ = result["key"].As(&key)
+	if obj, ok := fake.object("apiKey", id); !ok || obj["key"] != key || obj["name"] != "ci" {
+		t.Fatalf("API key %s wasn't created with key %q: %v", id, key, obj)
+	}
+
+	closeResp, err := server.CloseEphemeralResource(ctx, &tfprotov5.CloseEphemeralResourceRequest{
+		TypeName: "fusionauth_api_key",
+		Private:  openResp.Private,
+	})
+	if err != nil {
+		t.Fatalf("CloseEphemeralResource: %s", err)
+	}
+	testNoDiagnostics(t, closeResp.Diagnostics)
+
+	if _, ok := fake.object("apiKey", id); ok {
+		t.Errorf("API key %s wasn't deleted when closed", id)
+	}
+}
+
+func Test_ephemeralAPIKey_tenantScoped(t *testing.T) {
+	const otherTenantID = "6f1b6f5e-58a4-4c1f-a5f1-3c4c79f5a1f2"
+
+	ctx := context.Background()
+	fake := newFakeFusionAuth(fakeFusionAuthAPIKey)
+	fake.seed("tenant", map[string]interface{}{"id": otherTenantID, "name": "Other"})
+
+	var deleteTenants []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/api-key/") {
+			deleteTenants = append(deleteTenants, r.Header.Get("X-FusionAuth-TenantId"))
+		}
+		fake.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	// The provider is scoped to the default tenant, the key to another one.
+	hostURL, _ := url.Parse(srv.URL)
+	client := Client{
+		Host:          srv.URL,
+		APIKey:        fakeFusionAuthAPIKey,
+		FAClient:      *fusionauth.NewClient(srv.Client(), hostURL, fakeFusionAuthAPIKey),
+		ServerVersion: fakeFusionAuthVersion,
+	}
+	client.FAClient.TenantId = fakeDefaultTenantID
+	server := testConfiguredProviderServer(t, client)
+
+	openResp, err := server.OpenEphemeralResource(ctx, &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "fusionauth_api_key",
+		Config: testEphemeralConfig(t, server, "fusionauth_api_key", map[string]tftypes.Value{
+			"tenant_id": tftypes.NewValue(tftypes.String, otherTenantID),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("OpenEphemeralResource: %s", err)
+	}
+	testNoDiagnostics(t, openResp.Diagnostics)
+
+	closeResp, err := server.CloseEphemeralResource(ctx, &tfprotov5.CloseEphemeralResourceRequest{
+		TypeName: "fusionauth_api_key",
+		Private:  openResp.Private,
+	})
+	if err != nil {
+		t.Fatalf("CloseEphemeralResource: %s", err)
+	}
+	testNoDiagnostics(t, closeResp.Diagnostics)
+
+	if len(deleteTenants) != 1 || deleteTenants[0] != otherTenantID {
+		t.Errorf("the API key was deleted with tenants %q, want [%q]", deleteTenants, otherTenantID)
+	}
+}
+
+func Test_ephemeralApplicationCredentials(t *testing.T) {
+	ctx := context.Background()
+	fake, client := newFakeFusionAuthClient(t)
+	fake.seed("application", map[string]interface{}{
+		"id":       "85a03867-dccf-4882-adde-1a79aeec50df",
+		"name":     "Web",
+		"tenantId": fakeDefaultTenantID,
+		"oauthConfiguration": map[string]interface{}{
+			"clientId":     "85a03867-dccf-4882-adde-1a79aeec50df",
+			"clientSecret": "s3cret",
+		},
+	})
+	server := testConfiguredProviderServer(t, client)
+
+	openResp, err := server.OpenEphemeralResource(ctx, &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "fusionauth_application_credentials",
+		Config: testEphemeralConfig(t, server, "fusionauth_application_credentials", map[string]tftypes.Value{
+			"application_id": tftypes.NewValue(tftypes.String, "85a03867-dccf-4882-adde-1a79aeec50df"),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("OpenEphemeralResource: %s", err)
+	}
+	testNoDiagnostics(t, openResp.Diagnostics)
+
+	result := testEphemeralResult(t, server, "fusionauth_application_credentials", openResp.Result)
+	var clientID, clientSecret string
+	_ = result["client_id"].As(&clientID)
+	_ = result["client_secret"].As(&clientSecret)
+	if clientID != "85a03867-dccf-4882-adde-1a79aeec50df" || clientSecret != "s3cret" {
+		t.Errorf("credentials = %q, %q", clientID, clientSecret)
+	}
+}
+
+func testProviderServer(t *testing.T) tfprotov5.ProviderServer {
+	t.Helper()
+
+	providerServer, err := ProviderServer(context.Background())
+	if err != nil {
+		t.Fatalf("ProviderServer: %s", err)
+	}
+	return providerServer()
+}
+
+// testConfiguredProviderServer returns a provider server configured to use
+// the FusionAuth client points at.
+func testConfiguredProviderServer(t *testing.T, client Client) tfprotov5.ProviderServer {
+	t.Helper()
+
+	server := testProviderServer(t)
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %s", err)
+	}
+
+	config := testDynamicValue(t, schemaResp.Provider.ValueType(), map[string]tftypes.Value{
+		"host":    tftypes.NewValue(tftypes.String, client.Host),
+		"api_key": tftypes.NewValue(tftypes.String, client.APIKey),
+	})
+	resp, err := server.ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{Config: config})
+	if err != nil {
+		t.Fatalf("ConfigureProvider: %s", err)
+	}
+	testNoDiagnostics(t, resp.Diagnostics)
+
+	return server
+}
+
+func testEphemeralConfig(t *testing.T, server tfprotov5.ProviderServer, typeName string, values map[string]tftypes.Value) *tfprotov5.DynamicValue {
+	t.Helper()
+
+	schemaResp, _ := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	return testDynamicValue(t, schemaResp.EphemeralResourceSchemas[typeName].ValueType(), values)
+}
+
+func testEphemeralResult(t *testing.T, server tfprotov5.ProviderServer, typeName string, result *tfprotov5.DynamicValue) map[string]tftypes.Value {
+	t.Helper()
+
+	schemaResp, _ := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	v, err := result.Unmarshal(schemaResp.EphemeralResourceSchemas[typeName].ValueType())
+	if err != nil {
+		t.Fatalf("result: %s", err)
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		t.Fatalf("result: %s", err)
+	}
+	return attributes
+}
+
+// testDynamicValue returns an object of type typ with the attributes in
+// values set, and every other attribute null.
+func testDynamicValue(t *testing.T, typ tftypes.Type, values map[string]tftypes.Value) *tfprotov5.DynamicValue {
+	t.Helper()
+
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range typ.(tftypes.Object).AttributeTypes {
+		if v, ok := values[name]; ok {
+			attributes[name] = v
+			continue
+		}
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	dv, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, attributes))
+	if err != nil {
+		t.Fatalf("NewDynamicValue: %s", err)
+	}
+	return &dv
+}
+
+func testNoDiagnostics(t *testing.T, diags []*tfprotov5.Diagnostic) {
+	t.Helper()
+
+	for _, d := range diags {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
@@ -25,6 +26,29 @@ const (
 // testAccProviderFactories is a static map containing only the main provider instance
 var testAccProviderFactories map[string]func() (*schema.Provider, error)
 
+// testAccFakeServer is set when the acceptance tests run against the in-memory
+// fake FusionAuth instead of a live instance, see TestMain.
+var testAccFakeServer bool
+
+// TestMain points the acceptance tests at an in-memory fake FusionAuth when
+// FA_FAKE_SERVER is set, so they can run offline, e.g.
+//
+//	FA_FAKE_SERVER=1 go test ./fusionauth/... -run TestAccFusionauthTenant
+func TestMain(m *testing.M) {
+	if os.Getenv("FA_FAKE_SERVER") == "" {
+		os.Exit(m.Run())
+	}
+
+	srv := httptest.NewServer(newFakeFusionAuth(fakeFusionAuthAPIKey))
+	testAccFakeServer = true
+	_ = os.Setenv("FA_DOMAIN", srv.URL)
+	_ = os.Setenv("FA_API_KEY", fakeFusionAuthAPIKey)
+
+	code := m.Run()
+	srv.Close()
+	os.Exit(code)
+}
+
 func init() {
 	// Always allocate a new provider instance each invocation, otherwise gRPC
 	// ProviderConfigure() can overwrite configuration during concurrent testing.
@@ -64,6 +88,18 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccResourceTest runs an acceptance test case. Against the fake FusionAuth
+// the case runs as a unit test, as it doesn't need TF_ACC to be set.
+func testAccResourceTest(t *testing.T, c resource.TestCase) {
+	t.Helper()
+
+	if testAccFakeServer {
+		resource.UnitTest(t, c)
+		return
+	}
+	resource.Test(t, c)
+}
+
 // testCheckResourceAttrJSON compares the specified resource's JSON serialized
 // attribute data against the expected data.
 //
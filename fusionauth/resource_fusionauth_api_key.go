@@ -21,6 +21,7 @@ func resourceAPIKey() *schema.Resource {
 			"tenant_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				Description:  "The unique Id of the Tenant. This value is required if the key is meant to be tenant scoped. Tenant scoped keys can only be used to access users and other tenant scoped objects for the specified tenant. This value is read-only once the key is created.",
 				ValidateFunc: validation.IsUUID,
 				ForceNew:     true,
@@ -233,7 +234,7 @@ func resourceAPIKey() *schema.Resource {
 	}
 }
 
-// func createAPIKey(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+// func createAPIKey(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 // 	client := i.(Client)
 // 	ak := buildAPIKey(data)
 
@@ -254,17 +255,12 @@ func resourceAPIKey() *schema.Resource {
 // 	return buildResourceDataFromAPIKey(data, resp.ApiKey)
 // }
 
-func createAPIKey(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func createAPIKey(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	ak := buildAPIKey(data)
-
-	oldTenantID := client.FAClient.TenantId
-	client.FAClient.TenantId = ak.TenantId
-	defer func() {
-		client.FAClient.TenantId = oldTenantID
-	}()
+	client := i.(Client).withTenantID(ak.TenantId)
+	ak.TenantId = client.FAClient.TenantId
 	kid := data.Get("key_id").(string)
-	resp, faErrs, err := createManualAPIKey(context.Background(), client.FAClient, kid, manualAPIKeyRequest{
+	resp, faErrs, err := createManualAPIKey(ctx, client.FAClient, kid, manualAPIKeyRequest{
 		ApiKey: convertToManualAPIKey(ak),
 	})
 	if err != nil {
@@ -277,11 +273,11 @@ func createAPIKey(_ context.Context, data *schema.ResourceData, i interface{}) d
 	return buildResourceDataFromAPIKey(data, convertFromManualAPIKey(resp.ApiKey))
 }
 
-func readAPIKey(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func readAPIKey(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := tenantScopedClient(i.(Client), data)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.RetrieveAPIKey(id)
+	resp, faErrs, err := client.FAClient.RetrieveAPIKeyWithContext(ctx, id)
 	if err != nil {
 		return diag.Errorf("readAPIKey errors: %v", err)
 	}
@@ -322,17 +318,11 @@ func readAPIKey(_ context.Context, data *schema.ResourceData, i interface{}) dia
 // 	return buildResourceDataFromAPIKey(data, resp.ApiKey)
 // }
 
-func updateAPIKey(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func updateAPIKey(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	ak := buildAPIKey(data)
+	client := i.(Client).withTenantID(ak.TenantId)
 
-	oldTenantID := client.FAClient.TenantId
-	client.FAClient.TenantId = ak.TenantId
-	defer func() {
-		client.FAClient.TenantId = oldTenantID
-	}()
-
-	resp, faErrs, err := updateManualAPIKey(context.Background(), client.FAClient, data.Id(), manualAPIKeyRequest{
+	resp, faErrs, err := updateManualAPIKey(ctx, client.FAClient, data.Id(), manualAPIKeyRequest{
 		ApiKey: convertToManualAPIKey(ak),
 	})
 	if err != nil {
@@ -346,9 +336,9 @@ func updateAPIKey(_ context.Context, data *schema.ResourceData, i interface{}) d
 	return buildResourceDataFromAPIKey(data, convertFromManualAPIKey(resp.ApiKey))
 }
 
-func deleteAPIKey(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	resp, faErrs, err := client.FAClient.DeleteAPIKey(data.Id())
+func deleteAPIKey(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := tenantScopedClient(i.(Client), data)
+	resp, faErrs, err := client.FAClient.DeleteAPIKeyWithContext(ctx, data.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -387,28 +377,34 @@ func buildAPIKey(data *schema.ResourceData) fusionauth.APIKey {
 	for _, x := range l {
 		ac := x.(map[string]interface{})
 		ep := ac["endpoint"].(string)
-		ss := []string{}
-		if ac["delete"].(bool) {
-			ss = append(ss, "DELETE")
-		}
-		if ac["get"].(bool) {
-			ss = append(ss, "GET")
-		}
-		if ac["patch"].(bool) {
-			ss = append(ss, "PATCH")
-		}
-		if ac["post"].(bool) {
-			ss = append(ss, "POST")
-		}
-		if ac["put"].(bool) {
-			ss = append(ss, "PUT")
-		}
-		m[ep] = ss
+		m[ep] = apiKeyMethods(ac["delete"].(bool), ac["get"].(bool), ac["patch"].(bool), ac["post"].(bool), ac["put"].(bool))
 	}
 	ak.Permissions.Endpoints = m
 	return ak
 }
 
+// apiKeyMethods returns the HTTP methods an API key is permitted to use
+// against an endpoint.
+func apiKeyMethods(del, get, patch, post, put bool) []string {
+	ss := []string{}
+	if del {
+		ss = append(ss, "DELETE")
+	}
+	if get {
+		ss = append(ss, "GET")
+	}
+	if patch {
+		ss = append(ss, "PATCH")
+	}
+	if post {
+		ss = append(ss, "POST")
+	}
+	if put {
+		ss = append(ss, "PUT")
+	}
+	return ss
+}
+
 func buildResourceDataFromAPIKey(data *schema.ResourceData, res fusionauth.APIKey) diag.Diagnostics {
 	if err := data.Set("tenant_id", res.TenantId); err != nil {
 		return diag.Errorf("apiKey.tenant_id: %s", err.Error())
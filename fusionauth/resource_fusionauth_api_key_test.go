@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,7 +24,7 @@ func TestAccAPIKey_basic(t *testing.T) {
 	startName := "test-api-key"
 	endName := "updated-test-api-key"
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckAPIKeyDestroy,
@@ -69,7 +73,7 @@ func TestAccAPIKey_withPermissions(t *testing.T) {
 	description := "Test API Key with Permissions"
 	name := "test-api-key-permissions"
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckAPIKeyDestroy,
@@ -104,7 +108,7 @@ func TestAccAPIKey_tenantScoped(t *testing.T) {
 	description := "Tenant Scoped API Key"
 	name := "test-tenant-api-key"
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckAPIKeyDestroy,
@@ -296,3 +300,68 @@ resource "fusionauth_api_key" "test_%[1]s" {
 }
 `, resourceName, description, name)
 }
+
+func Test_createAndUpdateAPIKey_useContext(t *testing.T) {
+	_, client := newFakeFusionAuthClient(t)
+
+	// A cancelled context, e.g. once the timeouts block's timeout has passed,
+	// must stop the request.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	data := resourceAPIKey().TestResourceData()
+	if diags := createAPIKey(ctx, data, client); !diags.HasError() {
+		t.Error("createAPIKey succeeded with a cancelled context")
+	}
+
+	data.SetId("6f1b6f5e-58a4-4c1f-a5f1-3c4c79f5a1f2")
+	if diags := updateAPIKey(ctx, data, client); !diags.HasError() {
+		t.Error("updateAPIKey succeeded with a cancelled context")
+	}
+}
+
+func Test_readAndDeleteAPIKey_tenantScoped(t *testing.T) {
+	const (
+		keyID    = "4a3c9e1d-7b2f-4c85-9e6a-1d0f3b2c5a77"
+		tenantID = "1d2f3a4b-5c6d-4e7f-8a9b-0c1d2e3f4a5b"
+	)
+
+	fake := newFakeFusionAuth(fakeFusionAuthAPIKey)
+	fake.seed("tenant", map[string]interface{}{"id": tenantID, "name": "Hooli"})
+	fake.seed("apiKey", map[string]interface{}{"id": keyID, "key": "hooli-key", "tenantId": tenantID})
+
+	var tenantHeaders []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/api/api-key/") {
+			tenantHeaders = append(tenantHeaders, r.Header.Get("X-FusionAuth-TenantId"))
+		}
+		fake.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	hostURL, _ := url.Parse(srv.URL)
+	client := Client{
+		Host:     srv.URL,
+		APIKey:   fakeFusionAuthAPIKey,
+		FAClient: *fusionauth.NewClient(srv.Client(), hostURL, fakeFusionAuthAPIKey),
+	}
+
+	data := resourceAPIKey().TestResourceData()
+	data.SetId(keyID)
+	_ = data.Set("tenant_id", tenantID)
+	if diags := readAPIKey(context.Background(), data, client); diags.HasError() {
+		t.Fatalf("readAPIKey: %v", diags)
+	}
+	if diags := deleteAPIKey(context.Background(), data, client); diags.HasError() {
+		t.Fatalf("deleteAPIKey: %v", diags)
+	}
+
+	if len(tenantHeaders) != 2 {
+		t.Fatalf("got %d API key requests, want 2", len(tenantHeaders))
+	}
+	for _, got := range tenantHeaders {
+		if got != tenantID {
+			t.Errorf("X-FusionAuth-TenantId = %q, want %q", got, tenantID)
+		}
+	}
+}
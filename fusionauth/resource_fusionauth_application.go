@@ -2,6 +2,7 @@ package fusionauth
 
 import (
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -12,8 +13,17 @@ func newApplication() *schema.Resource {
 		ReadContext:   readApplication,
 		UpdateContext: updateApplication,
 		DeleteContext: deleteApplication,
+		CustomizeDiff: customdiff.Sequence(
+			requireServerVersion(
+				versionedAttribute{Attribute: "webauthn_configuration", MinVersion: "1.41.0"},
+				versionedAttribute{Attribute: "phone_configuration", MinVersion: "1.59.0"},
+				versionedAttribute{Attribute: "universal_configuration", MinVersion: "1.63.0"},
+				versionedAttribute{Attribute: "base_url", MinVersion: "1.68.0"},
+			),
+			validateConfig(validateOAuthGrants),
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("application", listExportApplications, true),
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -33,6 +43,7 @@ func newApplication() *schema.Resource {
 			"tenant_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.IsUUID,
 			},
 			"active": {
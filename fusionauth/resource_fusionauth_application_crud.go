@@ -10,40 +10,43 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func createApplication(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func createApplication(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	ar := fusionauth.ApplicationRequest{
 		Application: buildApplication(data),
 	}
-
-	oldTenantID := client.FAClient.TenantId
-	client.FAClient.TenantId = ar.Application.TenantId
-	defer func() {
-		client.FAClient.TenantId = oldTenantID
-	}()
+	client := i.(Client).withTenantID(ar.Application.TenantId)
+	if ar.Application.UniversalConfiguration.Universal {
+		// Universal applications don't belong to a tenant, so the provider
+		// level default must not be applied.
+		client.FAClient.TenantId = ""
+	}
+	ar.Application.TenantId = client.FAClient.TenantId
 
 	var aid string
 	if a, ok := data.GetOk("application_id"); ok {
 		aid = a.(string)
 	}
+	if err := waitForPendingDeletion(ctx, "application", aid, retrieveApplicationState(client, aid)); err != nil {
+		return diag.FromErr(err)
+	}
 
-	resp, faErrs, err := client.FAClient.CreateApplication(aid, ar)
+	resp, faErrs, err := client.FAClient.CreateApplicationWithContext(ctx, aid, ar)
 	if err != nil {
 		return diag.Errorf("CreateApplication errors: %v", err)
 	}
 	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+		return responseErrorDiags(err, newApplication(), "application")
 	}
 
 	data.SetId(resp.Application.Id)
 	return buildResourceDataFromApplication(resp.Application, data)
 }
 
-func readApplication(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func readApplication(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := tenantScopedClient(i.(Client), data)
 	id := data.Id()
 
-	resp, err := client.FAClient.RetrieveApplication(id)
+	resp, err := client.FAClient.RetrieveApplicationWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -59,30 +62,34 @@ func readApplication(_ context.Context, data *schema.ResourceData, i interface{}
 	return buildResourceDataFromApplication(resp.Application, data)
 }
 
-func updateApplication(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func updateApplication(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := tenantScopedClient(i.(Client), data)
 	ar := fusionauth.ApplicationRequest{
 		Application: buildApplication(data),
 	}
 
-	resp, faErrs, err := client.FAClient.UpdateApplication(data.Id(), ar)
+	resp, faErrs, err := client.FAClient.UpdateApplicationWithContext(ctx, data.Id(), ar)
 	if err != nil {
 		return diag.Errorf("UpdateApplication err: %v", err)
 	}
 	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+		return responseErrorDiags(err, newApplication(), "application")
 	}
 
 	return nil
 }
 
-func deleteApplication(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	resp, faErrs, err := client.FAClient.DeleteApplication(data.Id())
+func deleteApplication(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := tenantScopedClient(i.(Client), data)
+	resp, faErrs, err := client.FAClient.DeleteApplicationWithContext(ctx, data.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
+		return responseErrorDiags(err, newApplication(), "application")
+	}
+
+	if err := waitForDeletion(ctx, "application", data.Id(), retrieveApplicationState(client, data.Id())); err != nil {
 		return diag.FromErr(err)
 	}
 
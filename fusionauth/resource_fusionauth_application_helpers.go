@@ -2,6 +2,7 @@ package fusionauth
 
 import (
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -702,3 +703,25 @@ func buildResourceDataFromApplication(a fusionauth.Application, data *schema.Res
 
 	return nil
 }
+
+// validateOAuthGrants checks that the grants in
+// oauth_configuration.enabled_grants come with the configuration FusionAuth
+// requires for them.
+func validateOAuthGrants(config cty.Value) error {
+	oauthPath := cty.GetAttrPath("oauth_configuration").IndexInt(0)
+	grantsPath := oauthPath.GetAttr("enabled_grants")
+
+	for _, grant := range []string{"authorization_code", "implicit"} {
+		redirectPath := oauthPath.GetAttr("authorized_redirect_urls")
+		if configContains(config, grantsPath, grant) && !configSet(config, redirectPath) {
+			return redirectPath.NewErrorf("authorized_redirect_urls must contain at least one URL when the %s grant is enabled", grant)
+		}
+	}
+
+	verificationPath := oauthPath.GetAttr("device_verification_url")
+	if configContains(config, grantsPath, "device_code") && !configSet(config, verificationPath) {
+		return verificationPath.NewErrorf("device_verification_url is required when the device_code grant is enabled")
+	}
+
+	return nil
+}
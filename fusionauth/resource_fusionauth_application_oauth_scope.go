@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -112,7 +111,7 @@ func buildApplicationOAuthScope(data *schema.ResourceData) fusionauth.Applicatio
 	return oas
 }
 
-func createApplicationOAuthScope(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createApplicationOAuthScope(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	oas := buildApplicationOAuthScope(data)
 
@@ -121,7 +120,7 @@ func createApplicationOAuthScope(_ context.Context, data *schema.ResourceData, i
 		scopeID = sid.(string)
 	}
 
-	resp, faErrs, err := client.FAClient.CreateOAuthScope(oas.Scope.ApplicationId, scopeID, oas)
+	resp, faErrs, err := client.FAClient.CreateOAuthScopeWithContext(ctx, oas.Scope.ApplicationId, scopeID, oas)
 	if err != nil {
 		return diag.Errorf("CreateApplicationOAuthScope err: %v", err)
 	}
@@ -134,30 +133,46 @@ func createApplicationOAuthScope(_ context.Context, data *schema.ResourceData, i
 	return nil
 }
 
-func importApplicationOAuthScope(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
-	// Split the import ID on :
-	idParts := strings.Split(d.Id(), ":")
-	if len(idParts) != 2 {
-		return nil, fmt.Errorf("unexpected format of ID (%q), expected application_id:scope_id", d.Id())
+func importApplicationOAuthScope(ctx context.Context, d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseImportID(d.Id(), "application_id/scope_id", "application_id/name", "application_id:scope_id")
+	if err != nil {
+		return nil, err
 	}
 
-	applicationID := idParts[0]
-	scopeID := idParts[1]
+	scopeID := parts["scope_id"]
+	if name, ok := parts["name"]; ok {
+		client := i.(Client)
+		resp, err := client.FAClient.RetrieveApplicationWithContext(ctx, parts["application_id"])
+		if err == nil {
+			err = checkResponse(resp.StatusCode, nil)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("retrieving application %s: %w", parts["application_id"], err)
+		}
+
+		scopes := make(map[string]string, len(resp.Application.Scopes))
+		for _, scope := range resp.Application.Scopes {
+			scopes[scope.Id] = scope.Name
+		}
+		if scopeID, err = lookupChildName("OAuth scope", name, scopes); err != nil {
+			return nil, err
+		}
+	}
 
 	d.SetId(scopeID)
-	if err := d.Set("application_id", applicationID); err != nil {
+	if err := d.Set("application_id", parts["application_id"]); err != nil {
 		return nil, err
 	}
 
 	return []*schema.ResourceData{d}, nil
 }
 
-func readApplicationOAuthScope(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readApplicationOAuthScope(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	aid := data.Get("application_id").(string)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.RetrieveOAuthScope(aid, id)
+	resp, faErrs, err := client.FAClient.RetrieveOAuthScopeWithContext(ctx, aid, id)
 	if err != nil {
 		return diag.Errorf("RetrieveApplicationOAuthScope err: %v", err)
 	}
@@ -204,11 +219,11 @@ func readApplicationOAuthScope(_ context.Context, data *schema.ResourceData, i i
 	return nil
 }
 
-func updateApplicationOAuthScope(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateApplicationOAuthScope(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	oas := buildApplicationOAuthScope(data)
 	id := data.Id()
-	resp, faErrs, err := client.FAClient.UpdateOAuthScope(oas.Scope.ApplicationId, id, oas)
+	resp, faErrs, err := client.FAClient.UpdateOAuthScopeWithContext(ctx, oas.Scope.ApplicationId, id, oas)
 
 	if err != nil {
 		return diag.Errorf("UpdateApplicationOAuthScope err: %v", err)
@@ -221,12 +236,12 @@ func updateApplicationOAuthScope(_ context.Context, data *schema.ResourceData, i
 	return nil
 }
 
-func deleteApplicationOAuthScope(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteApplicationOAuthScope(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	aid := data.Get("application_id").(string)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteOAuthScope(aid, id)
+	resp, faErrs, err := client.FAClient.DeleteOAuthScopeWithContext(ctx, aid, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
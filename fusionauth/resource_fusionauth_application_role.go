@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -62,13 +61,13 @@ func buildApplicationRole(data *schema.ResourceData) fusionauth.ApplicationRole
 	}
 }
 
-func createApplicationRole(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createApplicationRole(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
 	ar := buildApplicationRole(data)
 	aid := data.Get("application_id").(string)
 
-	resp, faErrs, err := client.FAClient.CreateApplicationRole(
+	resp, faErrs, err := client.FAClient.CreateApplicationRoleWithContext(ctx,
 		aid, "", fusionauth.ApplicationRequest{Role: ar},
 	)
 
@@ -83,31 +82,47 @@ func createApplicationRole(_ context.Context, data *schema.ResourceData, i inter
 	return applicationRoleToData(data, aid, resp)
 }
 
-func importApplicationRole(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
-	// Split the import ID on :
-	idParts := strings.Split(d.Id(), ":")
-	if len(idParts) != 2 {
-		return nil, fmt.Errorf("unexpected format of ID (%q), expected application_id:role_id", d.Id())
+func importApplicationRole(ctx context.Context, d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseImportID(d.Id(), "application_id/role_id", "application_id/name", "application_id:role_id")
+	if err != nil {
+		return nil, err
 	}
 
-	applicationID := idParts[0]
-	roleID := idParts[1]
+	roleID := parts["role_id"]
+	if name, ok := parts["name"]; ok {
+		client := i.(Client)
+		resp, err := client.FAClient.RetrieveApplicationWithContext(ctx, parts["application_id"])
+		if err == nil {
+			err = checkResponse(resp.StatusCode, nil)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("retrieving application %s: %w", parts["application_id"], err)
+		}
+
+		roles := make(map[string]string, len(resp.Application.Roles))
+		for _, role := range resp.Application.Roles {
+			roles[role.Id] = role.Name
+		}
+		if roleID, err = lookupChildName("role", name, roles); err != nil {
+			return nil, err
+		}
+	}
 
 	d.SetId(roleID)
-	if err := d.Set("application_id", applicationID); err != nil {
+	if err := d.Set("application_id", parts["application_id"]); err != nil {
 		return nil, err
 	}
 
 	return []*schema.ResourceData{d}, nil
 }
 
-func readApplicationRole(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func readApplicationRole(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
 
 	// application roles are only returned via an application, so we need
 	// to grab the application and drill down into the linked roles.
 	appID := data.Get("application_id").(string)
-	resp, err := client.FAClient.RetrieveApplication(appID)
+	resp, err := client.FAClient.RetrieveApplicationWithContext(ctx, appID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -144,13 +159,13 @@ func readApplicationRole(_ context.Context, data *schema.ResourceData, i interfa
 	return diags
 }
 
-func updateApplicationRole(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateApplicationRole(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
 	ar := buildApplicationRole(data)
 	aid := data.Get("application_id").(string)
 
-	resp, faErrs, err := client.FAClient.UpdateApplicationRole(
+	resp, faErrs, err := client.FAClient.UpdateApplicationRoleWithContext(ctx,
 		aid, data.Id(), fusionauth.ApplicationRequest{Role: ar},
 	)
 
@@ -169,13 +184,13 @@ func updateApplicationRole(_ context.Context, data *schema.ResourceData, i inter
 	return applicationRoleToData(data, aid, resp)
 }
 
-func deleteApplicationRole(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteApplicationRole(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
 	id := data.Id()
 	aid := data.Get("application_id").(string)
 
-	resp, faErrs, err := client.FAClient.DeleteApplicationRole(aid, id)
+	resp, faErrs, err := client.FAClient.DeleteApplicationRoleWithContext(ctx, aid, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -108,7 +108,7 @@ func newConsent() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("consent", listImportConsents, false),
 		},
 	}
 }
@@ -141,11 +141,11 @@ func buildConsent(data *schema.ResourceData) fusionauth.Consent {
 	return consent
 }
 
-func createConsent(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createConsent(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	consent := buildConsent(data)
 
-	resp, faErrs, err := client.FAClient.CreateConsent(consent.Id, fusionauth.ConsentRequest{
+	resp, faErrs, err := client.FAClient.CreateConsentWithContext(ctx, consent.Id, fusionauth.ConsentRequest{
 		Consent: consent,
 	})
 	if err != nil {
@@ -159,11 +159,11 @@ func createConsent(_ context.Context, data *schema.ResourceData, i interface{})
 	return nil
 }
 
-func readConsent(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readConsent(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, err := client.FAClient.RetrieveConsent(id)
+	resp, err := client.FAClient.RetrieveConsentWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -215,11 +215,11 @@ func readConsent(_ context.Context, data *schema.ResourceData, i interface{}) di
 	return nil
 }
 
-func updateConsent(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateConsent(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	consent := buildConsent(data)
 
-	resp, faErrs, err := client.FAClient.UpdateConsent(data.Id(), fusionauth.ConsentRequest{
+	resp, faErrs, err := client.FAClient.UpdateConsentWithContext(ctx, data.Id(), fusionauth.ConsentRequest{
 		Consent: consent,
 	})
 	if err != nil {
@@ -233,11 +233,11 @@ func updateConsent(_ context.Context, data *schema.ResourceData, i interface{})
 	return nil
 }
 
-func deleteConsent(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteConsent(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteConsent(id)
+	resp, faErrs, err := client.FAClient.DeleteConsentWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
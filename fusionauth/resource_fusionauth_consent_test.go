@@ -22,7 +22,7 @@ func TestAccConsent(t *testing.T) {
 	startValues := []string{"email", "sms"}
 	endValues := []string{"email", "sms", "phone"}
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckConsentDestroy,
 		Steps: []resource.TestStep{
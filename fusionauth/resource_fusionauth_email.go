@@ -82,7 +82,7 @@ func newEmail() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("email template", listImportEmailTemplates, false),
 		},
 	}
 }
@@ -115,7 +115,7 @@ func buildEmail(data *schema.ResourceData) fusionauth.EmailTemplate {
 	return e
 }
 
-func createEmail(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createEmail(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	e := buildEmail(data)
 
@@ -124,7 +124,7 @@ func createEmail(_ context.Context, data *schema.ResourceData, i interface{}) di
 		eid = ei.(string)
 	}
 
-	resp, faErrs, err := client.FAClient.CreateEmailTemplate(eid, fusionauth.EmailTemplateRequest{
+	resp, faErrs, err := client.FAClient.CreateEmailTemplateWithContext(ctx, eid, fusionauth.EmailTemplateRequest{
 		EmailTemplate: e,
 	})
 
@@ -139,11 +139,11 @@ func createEmail(_ context.Context, data *schema.ResourceData, i interface{}) di
 	return nil
 }
 
-func readEmail(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readEmail(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, err := client.FAClient.RetrieveEmailTemplate(id)
+	resp, err := client.FAClient.RetrieveEmailTemplateWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -191,11 +191,11 @@ func readEmail(_ context.Context, data *schema.ResourceData, i interface{}) diag
 	return nil
 }
 
-func updateEmail(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateEmail(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	e := buildEmail(data)
 
-	resp, faErrs, err := client.FAClient.UpdateEmailTemplate(data.Id(), fusionauth.EmailTemplateRequest{
+	resp, faErrs, err := client.FAClient.UpdateEmailTemplateWithContext(ctx, data.Id(), fusionauth.EmailTemplateRequest{
 		EmailTemplate: e,
 	})
 
@@ -210,11 +210,11 @@ func updateEmail(_ context.Context, data *schema.ResourceData, i interface{}) di
 	return nil
 }
 
-func deleteEmail(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteEmail(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteEmailTemplate(id)
+	resp, faErrs, err := client.FAClient.DeleteEmailTemplateWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,6 +25,7 @@ func resourceEntity() *schema.Resource {
 			"tenant_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				Description:  "The unique Id of the tenant used to scope this API request.",
 				ValidateFunc: validation.IsUUID,
@@ -73,20 +73,16 @@ func resourceEntity() *schema.Resource {
 	}
 }
 
-func createEntity(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func createEntity(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	resourceReq, diags := dataToEntityRequest(data)
 	if diags != nil {
 		return diags
 	}
 
-	client := i.(Client)
-	oldTenantID := client.FAClient.TenantId
-	client.FAClient.TenantId = resourceReq.Entity.TenantId
-	defer func() {
-		client.FAClient.TenantId = oldTenantID
-	}()
+	client := i.(Client).withTenantID(resourceReq.Entity.TenantId)
+	resourceReq.Entity.TenantId = client.FAClient.TenantId
 
-	res, faErrs, err := client.FAClient.CreateEntity(resourceReq.Entity.Id, resourceReq)
+	res, faErrs, err := client.FAClient.CreateEntityWithContext(ctx, resourceReq.Entity.Id, resourceReq)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -98,15 +94,26 @@ func createEntity(_ context.Context, data *schema.ResourceData, i interface{}) (
 }
 
 func importEntity(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	// Support importing in the format "tenant_id:entity_id"
-	parts := strings.SplitN(d.Id(), ":", 2)
+	parts, err := parseImportID(d.Id(), "tenant_id/entity_id", "tenant_id/name", "tenant_id:entity_id", "name", "id")
+	if err != nil {
+		return nil, err
+	}
 
-	entityID := parts[1]
+	entityID := parts["entity_id"]
+	if id, ok := parts["id"]; ok {
+		entityID = id
+	}
+	if name, ok := parts["name"]; ok {
+		client := m.(Client).withTenantID(parts["tenant_id"])
+		if entityID, err = lookupImportName(ctx, client, "entity", name, listImportEntities(name)); err != nil {
+			return nil, err
+		}
+	}
 	d.SetId(entityID)
 
 	// If tenantId was provided in the import string, set it in the state
-	if len(parts) == 2 && parts[1] != "" {
-		if err := d.Set("tenant_id", parts[0]); err != nil {
+	if tenantID, ok := parts["tenant_id"]; ok {
+		if err := d.Set("tenant_id", tenantID); err != nil {
 			return nil, err
 		}
 	}
@@ -119,12 +126,10 @@ func importEntity(ctx context.Context, d *schema.ResourceData, m interface{}) ([
 	return []*schema.ResourceData{d}, nil
 }
 
-func readEntity(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
-	client := i.(Client)
-	revertTid := clientTenantIDOverride(&client, data)
-	defer revertTid()
+func readEntity(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+	client := tenantScopedClient(i.(Client), data)
 
-	res, faErrs, err := client.FAClient.RetrieveEntity(data.Id())
+	res, faErrs, err := client.FAClient.RetrieveEntityWithContext(ctx, data.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -140,17 +145,15 @@ func readEntity(_ context.Context, data *schema.ResourceData, i interface{}) (di
 	return entityResponseToData(data, res)
 }
 
-func updateEntity(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
-	client := i.(Client)
-	revertTid := clientTenantIDOverride(&client, data)
-	defer revertTid()
+func updateEntity(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+	client := tenantScopedClient(i.(Client), data)
 
 	req, diags := dataToEntityRequest(data)
 	if diags != nil {
 		return diags
 	}
 
-	res, faErrs, err := client.FAClient.UpdateEntity(data.Id(), req)
+	res, faErrs, err := client.FAClient.UpdateEntityWithContext(ctx, data.Id(), req)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -161,12 +164,10 @@ func updateEntity(_ context.Context, data *schema.ResourceData, i interface{}) (
 	return entityResponseToData(data, res)
 }
 
-func deleteEntity(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
-	client := i.(Client)
-	revertTid := clientTenantIDOverride(&client, data)
-	defer revertTid()
+func deleteEntity(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+	client := tenantScopedClient(i.(Client), data)
 
-	res, faErrs, err := client.FAClient.DeleteEntity(data.Id())
+	res, faErrs, err := client.FAClient.DeleteEntityWithContext(ctx, data.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
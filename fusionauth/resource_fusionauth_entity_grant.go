@@ -19,7 +19,7 @@ func resourceEntityGrant() *schema.Resource {
 		UpdateContext: updateEntityGrant,
 		DeleteContext: deleteEntityGrant,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importEntityGrant,
 		},
 		Schema: map[string]*schema.Schema{
 			"tenant_id": {
@@ -82,19 +82,11 @@ func createEntityGrant(ctx context.Context, data *schema.ResourceData, i interfa
 		return diags
 	}
 
-	client := i.(Client)
-
-	if iTenantID, ok := data.GetOk("tenant_id"); ok {
-		// Inject Tenant ID if specified...
-		oldTenantID := client.FAClient.TenantId
-		client.FAClient.TenantId = iTenantID.(string)
-		defer func() {
-			client.FAClient.TenantId = oldTenantID
-		}()
-	}
+	// Inject Tenant ID if specified...
+	client := tenantScopedClient(i.(Client), data)
 
 	entityID := data.Get("entity_id").(string)
-	res, faErrs, err := client.FAClient.UpsertEntityGrant(entityID, resourceReq)
+	res, faErrs, err := client.FAClient.UpsertEntityGrantWithContext(ctx, entityID, resourceReq)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -117,23 +109,15 @@ func syntheticGrantID(data *schema.ResourceData) string {
 	return fmt.Sprintf("%s_%s", entityID, userID)
 }
 
-func readEntityGrant(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
-	client := i.(Client)
-
-	if iTenantID, ok := data.GetOk("tenant_id"); ok {
-		// Inject Tenant ID if specified...
-		oldTenantID := client.FAClient.TenantId
-		client.FAClient.TenantId = iTenantID.(string)
-		defer func() {
-			client.FAClient.TenantId = oldTenantID
-		}()
-	}
+func readEntityGrant(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+	// Inject Tenant ID if specified...
+	client := tenantScopedClient(i.(Client), data)
 
 	entityID := data.Get("entity_id").(string)
 	recipientEntityID := data.Get("recipient_entity_id").(string)
 	userID := data.Get("user_id").(string)
 
-	res, faErrs, err := client.FAClient.RetrieveEntityGrant(entityID, recipientEntityID, userID)
+	res, faErrs, err := client.FAClient.RetrieveEntityGrantWithContext(ctx, entityID, recipientEntityID, userID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -157,19 +141,11 @@ func updateEntityGrant(ctx context.Context, data *schema.ResourceData, i interfa
 		return diags
 	}
 
-	client := i.(Client)
-
-	if iTenantID, ok := data.GetOk("tenant_id"); ok {
-		// Inject Tenant ID if specified...
-		oldTenantID := client.FAClient.TenantId
-		client.FAClient.TenantId = iTenantID.(string)
-		defer func() {
-			client.FAClient.TenantId = oldTenantID
-		}()
-	}
+	// Inject Tenant ID if specified...
+	client := tenantScopedClient(i.(Client), data)
 
 	entityID := data.Get("entity_id").(string)
-	res, faErrs, err := client.FAClient.UpsertEntityGrant(entityID, resourceReq)
+	res, faErrs, err := client.FAClient.UpsertEntityGrantWithContext(ctx, entityID, resourceReq)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -182,14 +158,14 @@ func updateEntityGrant(ctx context.Context, data *schema.ResourceData, i interfa
 	return readEntityGrant(ctx, data, i)
 }
 
-func deleteEntityGrant(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func deleteEntityGrant(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
 
 	entityID := data.Get("entity_id").(string)
 	recipientEntityID := data.Get("recipient_entity_id").(string)
 	userID := data.Get("user_id").(string)
 
-	resp, faErrs, err := client.FAClient.DeleteEntityGrant(entityID, recipientEntityID, userID)
+	resp, faErrs, err := client.FAClient.DeleteEntityGrantWithContext(ctx, entityID, recipientEntityID, userID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -236,3 +212,41 @@ func entityGrantResponseToData(data *schema.ResourceData, res *fusionauth.Entity
 
 	return setResourceData("entity_grant", data, dataMapping)
 }
+
+// importEntityGrant imports the grant of an entity to a user or to another
+// entity, whose Id is tried as a user's first.
+func importEntityGrant(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseImportID(d.Id(), "entity_id/recipient_id", "tenant_id/entity_id/recipient_id")
+	if err != nil {
+		return nil, err
+	}
+
+	if tenantID, ok := parts["tenant_id"]; ok {
+		if err := d.Set("tenant_id", tenantID); err != nil {
+			return nil, err
+		}
+	}
+	if err := d.Set("entity_id", parts["entity_id"]); err != nil {
+		return nil, err
+	}
+
+	for _, recipient := range []string{"user_id", "recipient_entity_id"} {
+		if err := d.Set(recipient, parts["recipient_id"]); err != nil {
+			return nil, err
+		}
+		d.SetId(syntheticGrantID(d))
+
+		if diags := readEntityGrant(ctx, d, m); diags.HasError() {
+			return nil, fmt.Errorf("failed to read imported entity grant: %v", diags[0].Summary)
+		}
+		if d.Id() != "" {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		if err := d.Set(recipient, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, fmt.Errorf("entity %s has no grant to a user or an entity with the Id %s", parts["entity_id"], parts["recipient_id"])
+}
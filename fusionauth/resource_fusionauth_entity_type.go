@@ -18,7 +18,7 @@ func resourceEntityType() *schema.Resource {
 		UpdateContext: updateEntityType,
 		DeleteContext: deleteEntityType,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("entity type", listImportEntityTypes, false),
 		},
 		Schema: map[string]*schema.Schema{
 			"entity_type_id": {
@@ -77,14 +77,14 @@ func resourceEntityType() *schema.Resource {
 	}
 }
 
-func createEntityType(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func createEntityType(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	req, diags := dataToEntityTypeRequest(data)
 	if diags != nil {
 		return diags
 	}
 
 	client := i.(Client)
-	res, faErrs, err := client.FAClient.CreateEntityType(req.EntityType.Id, req)
+	res, faErrs, err := client.FAClient.CreateEntityTypeWithContext(ctx, req.EntityType.Id, req)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -95,9 +95,9 @@ func createEntityType(_ context.Context, data *schema.ResourceData, i interface{
 	return entityTypeResponseToData(data, res)
 }
 
-func readEntityType(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func readEntityType(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
-	res, faErrs, err := client.FAClient.RetrieveEntityType(data.Id())
+	res, faErrs, err := client.FAClient.RetrieveEntityTypeWithContext(ctx, data.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -113,14 +113,14 @@ func readEntityType(_ context.Context, data *schema.ResourceData, i interface{})
 	return entityTypeResponseToData(data, res)
 }
 
-func updateEntityType(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func updateEntityType(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
 	req, diags := dataToEntityTypeRequest(data)
 	if diags != nil {
 		return diags
 	}
 
-	resp, faErrs, err := client.FAClient.UpdateEntityType(data.Id(), req)
+	resp, faErrs, err := client.FAClient.UpdateEntityTypeWithContext(ctx, data.Id(), req)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -131,11 +131,11 @@ func updateEntityType(_ context.Context, data *schema.ResourceData, i interface{
 	return entityTypeResponseToData(data, resp)
 }
 
-func deleteEntityType(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func deleteEntityType(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
 
 	resourceID := data.Id()
-	resp, faErrs, err := client.FAClient.DeleteEntityType(resourceID)
+	resp, faErrs, err := client.FAClient.DeleteEntityTypeWithContext(ctx, resourceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -66,7 +65,7 @@ func resourceEntityTypePermission() *schema.Resource {
 	}
 }
 
-func createEntityTypePermission(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func createEntityTypePermission(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	resourceReq, diags := dataToEntityTypePermissionRequest(data)
 	if diags != nil {
 		return diags
@@ -74,7 +73,7 @@ func createEntityTypePermission(_ context.Context, data *schema.ResourceData, i
 
 	client := i.(Client)
 	entityTypeID := data.Get("entity_type_id").(string)
-	res, faErrs, err := client.FAClient.CreateEntityTypePermission(entityTypeID, resourceReq.Permission.Id, resourceReq)
+	res, faErrs, err := client.FAClient.CreateEntityTypePermissionWithContext(ctx, entityTypeID, resourceReq.Permission.Id, resourceReq)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -86,18 +85,33 @@ func createEntityTypePermission(_ context.Context, data *schema.ResourceData, i
 }
 
 func importEntityTypePermission(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	// Support importing in the format "entity_type_id:permission_id"
-	parts := strings.SplitN(d.Id(), ":", 2)
-
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		return nil, fmt.Errorf("invalid import format, expected 'entity_type_id:permission_id'")
+	parts, err := parseImportID(d.Id(), "entity_type_id/permission_id", "entity_type_id/name", "entity_type_id:permission_id")
+	if err != nil {
+		return nil, err
 	}
 
-	entityTypeID := parts[0]
-	permissionID := parts[1]
+	permissionID := parts["permission_id"]
+	if name, ok := parts["name"]; ok {
+		client := m.(Client)
+		res, faErrs, err := client.FAClient.RetrieveEntityTypeWithContext(ctx, parts["entity_type_id"])
+		if err == nil {
+			err = checkResponse(res.StatusCode, faErrs)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("retrieving entity type %s: %w", parts["entity_type_id"], err)
+		}
+
+		permissions := make(map[string]string, len(res.EntityType.Permissions))
+		for _, permission := range res.EntityType.Permissions {
+			permissions[permission.Id] = permission.Name
+		}
+		if permissionID, err = lookupChildName("permission", name, permissions); err != nil {
+			return nil, err
+		}
+	}
 
 	// Set the entity_type_id in state
-	if err := d.Set("entity_type_id", entityTypeID); err != nil {
+	if err := d.Set("entity_type_id", parts["entity_type_id"]); err != nil {
 		return nil, err
 	}
 
@@ -113,13 +127,13 @@ func importEntityTypePermission(ctx context.Context, d *schema.ResourceData, m i
 	return []*schema.ResourceData{d}, nil
 }
 
-func readEntityTypePermission(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func readEntityTypePermission(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
 
 	// entity type permissions are only returned via an entity type, so we need
 	// to grab the entity type and drill down into the linked permissions.
 	entityTypeID := data.Get("entity_type_id").(string)
-	res, faErrs, err := client.FAClient.RetrieveEntityType(entityTypeID)
+	res, faErrs, err := client.FAClient.RetrieveEntityTypeWithContext(ctx, entityTypeID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -156,7 +170,7 @@ func readEntityTypePermission(_ context.Context, data *schema.ResourceData, i in
 	return diags
 }
 
-func updateEntityTypePermission(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func updateEntityTypePermission(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	req, diags := dataToEntityTypePermissionRequest(data)
 	if diags != nil {
 		return diags
@@ -164,7 +178,7 @@ func updateEntityTypePermission(_ context.Context, data *schema.ResourceData, i
 
 	client := i.(Client)
 	entityTypeID := data.Get("entity_type_id").(string)
-	res, faErrs, err := client.FAClient.UpdateEntityTypePermission(entityTypeID, data.Id(), req)
+	res, faErrs, err := client.FAClient.UpdateEntityTypePermissionWithContext(ctx, entityTypeID, data.Id(), req)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -175,12 +189,12 @@ func updateEntityTypePermission(_ context.Context, data *schema.ResourceData, i
 	return entityTypePermissionResponseToData(data, entityTypeID, res)
 }
 
-func deleteEntityTypePermission(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func deleteEntityTypePermission(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
 
 	resourceID := data.Id()
 	entityTypeID := data.Get("entity_type_id").(string)
-	res, faErrs, err := client.FAClient.DeleteEntityTypePermission(entityTypeID, resourceID)
+	res, faErrs, err := client.FAClient.DeleteEntityTypePermissionWithContext(ctx, entityTypeID, resourceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
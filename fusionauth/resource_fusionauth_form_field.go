@@ -130,7 +130,7 @@ func resourceFormField() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("form field", listImportFormFields, false),
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -143,7 +143,7 @@ func resourceFormField() *schema.Resource {
 	}
 }
 
-func createFormField(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createFormField(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	// Validate key is provided for non-consent types
 	formType := data.Get("type").(string)
 	key := data.Get("key").(string)
@@ -157,7 +157,7 @@ func createFormField(_ context.Context, data *schema.ResourceData, i interface{}
 	if fi, ok := data.GetOk("form_field_id"); ok {
 		fid = fi.(string)
 	}
-	resp, faErrs, err := client.FAClient.CreateFormField(fid, fusionauth.FormFieldRequest{Field: f})
+	resp, faErrs, err := client.FAClient.CreateFormFieldWithContext(ctx, fid, fusionauth.FormFieldRequest{Field: f})
 	if err != nil {
 		return diag.Errorf("createFormField err: %v", err)
 	}
@@ -168,11 +168,11 @@ func createFormField(_ context.Context, data *schema.ResourceData, i interface{}
 	return buildResourceDataFromFormField(data, resp.Field)
 }
 
-func readFormField(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readFormField(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, err := client.FAClient.RetrieveFormField(id)
+	resp, err := client.FAClient.RetrieveFormFieldWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -188,11 +188,11 @@ func readFormField(_ context.Context, data *schema.ResourceData, i interface{})
 	return buildResourceDataFromFormField(data, resp.Field)
 }
 
-func updateFormField(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateFormField(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	f := buildFormField(data)
 
-	resp, faErrs, err := client.FAClient.UpdateFormField(data.Id(), fusionauth.FormFieldRequest{Field: f})
+	resp, faErrs, err := client.FAClient.UpdateFormFieldWithContext(ctx, data.Id(), fusionauth.FormFieldRequest{Field: f})
 	if err != nil {
 		return diag.Errorf("UpdateFormField err: %v", err)
 	}
@@ -203,11 +203,11 @@ func updateFormField(_ context.Context, data *schema.ResourceData, i interface{}
 	return buildResourceDataFromFormField(data, resp.Field)
 }
 
-func deleteFormField(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteFormField(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteFormField(id)
+	resp, faErrs, err := client.FAClient.DeleteFormFieldWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -82,7 +82,7 @@ func newGenericConnector() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("connector", listImportConnectors(fusionauth.ConnectorType_Generic), false),
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -208,11 +208,11 @@ func updateGenericConnector(ctx context.Context, data *schema.ResourceData, i in
 	return nil
 }
 
-func deleteGenericConnector(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteGenericConnector(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteConnector(id)
+	resp, faErrs, err := client.FAClient.DeleteConnectorWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
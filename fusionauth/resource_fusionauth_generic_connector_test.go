@@ -22,7 +22,7 @@ func TestAccGenericConnector(t *testing.T) {
 	startName, endName := "my-test-connector", "my-new-test-connector"
 	startReadTimeout, endReadTimeout := "1111", "2222"
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckGenericConnectorDestroy,
 		Steps: []resource.TestStep{
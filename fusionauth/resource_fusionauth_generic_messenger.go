@@ -11,7 +11,7 @@ import (
 )
 
 func newGenericMessenger() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: createGenericMessenger,
 		ReadContext:   readGenericMessenger,
 		UpdateContext: updateGenericMessenger,
@@ -84,9 +84,13 @@ func newGenericMessenger() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("messenger", listImportMessengers(fusionauth.MessengerType_Generic), false),
 		},
 	}
+
+	addWriteOnlySchema(r.Schema, "http_authentication_password", "")
+
+	return r
 }
 
 func buildGenericMessenger(data *schema.ResourceData) fusionauth.GenericMessengerConfiguration {
@@ -98,7 +102,7 @@ func buildGenericMessenger(data *schema.ResourceData) fusionauth.GenericMessenge
 			Type:  fusionauth.MessengerType_Generic,
 		},
 		ConnectTimeout:             data.Get("connect_timeout").(int),
-		HttpAuthenticationPassword: data.Get("http_authentication_password").(string),
+		HttpAuthenticationPassword: sensitiveString(data, "http_authentication_password"),
 		HttpAuthenticationUsername: data.Get("http_authentication_username").(string),
 		ReadTimeout:                data.Get("read_timeout").(int),
 		SslCertificate:             data.Get("ssl_certificate").(string),
@@ -167,8 +171,10 @@ func readGenericMessenger(ctx context.Context, data *schema.ResourceData, i inte
 	if err := data.Set("headers", messenger.Headers); err != nil {
 		return diag.Errorf("messenger.headers: %s", err.Error())
 	}
-	if err := data.Set("http_authentication_password", messenger.HttpAuthenticationPassword); err != nil {
-		return diag.Errorf("messenger.http_authentication_password: %s", err.Error())
+	if !usesWriteOnly(data, "http_authentication_password") {
+		if err := data.Set("http_authentication_password", messenger.HttpAuthenticationPassword); err != nil {
+			return diag.Errorf("messenger.http_authentication_password: %s", err.Error())
+		}
 	}
 	if err := data.Set("http_authentication_username", messenger.HttpAuthenticationUsername); err != nil {
 		return diag.Errorf("messenger.http_authentication_username: %s", err.Error())
@@ -205,11 +211,11 @@ func updateGenericMessenger(ctx context.Context, data *schema.ResourceData, i in
 	return nil
 }
 
-func deleteGenericMessenger(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteGenericMessenger(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteMessenger(id)
+	resp, faErrs, err := client.FAClient.DeleteMessengerWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
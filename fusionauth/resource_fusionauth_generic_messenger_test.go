@@ -22,7 +22,7 @@ func TestAccGenericMessenger(t *testing.T) {
 	startName, endName := "my-test-messenger", "my-new-test-messenger"
 	startReadTimeout, endReadTimeout := "1111", "2222"
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckGenericMessengerDestroy,
 		Steps: []resource.TestStep{
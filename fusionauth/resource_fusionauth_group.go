@@ -46,13 +46,14 @@ func newGroup() *schema.Resource {
 			},
 			"tenant_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				Description:  "The unique Id of the tenant used to scope this API request.",
 				ValidateFunc: validation.IsUUID,
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("group", listImportGroups, true),
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -86,15 +87,11 @@ func buildGroup(data *schema.ResourceData) fusionauth.GroupRequest {
 	return g
 }
 
-func createGroup(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func createGroup(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	g := buildGroup(data)
-	oldTenantID := client.FAClient.TenantId
-	client.FAClient.TenantId = g.Group.TenantId
-	defer func() {
-		client.FAClient.TenantId = oldTenantID
-	}()
-	resp, faErrs, err := client.FAClient.CreateGroup(g.Group.Id, g)
+	client := i.(Client).withTenantID(g.Group.TenantId)
+	g.Group.TenantId = client.FAClient.TenantId
+	resp, faErrs, err := client.FAClient.CreateGroupWithContext(ctx, g.Group.Id, g)
 	if err != nil {
 		return diag.Errorf("CreateGroup err: %v", err)
 	}
@@ -107,11 +104,11 @@ func createGroup(_ context.Context, data *schema.ResourceData, i interface{}) di
 	return nil
 }
 
-func readGroup(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func readGroup(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := tenantScopedClient(i.(Client), data)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.RetrieveGroup(id)
+	resp, faErrs, err := client.FAClient.RetrieveGroupWithContext(ctx, id)
 	if err != nil {
 		return diag.Errorf("RetrieveGroup err: %v", err)
 	}
@@ -153,16 +150,11 @@ func readGroup(_ context.Context, data *schema.ResourceData, i interface{}) diag
 	return nil
 }
 
-func updateGroup(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func updateGroup(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	g := buildGroup(data)
 	id := data.Id()
-	oldTenantID := client.FAClient.TenantId
-	client.FAClient.TenantId = g.Group.TenantId
-	defer func() {
-		client.FAClient.TenantId = oldTenantID
-	}()
-	resp, faErrs, err := client.FAClient.UpdateGroup(id, g)
+	client := tenantScopedClient(i.(Client), data)
+	resp, faErrs, err := client.FAClient.UpdateGroupWithContext(ctx, id, g)
 
 	if err != nil {
 		return diag.Errorf("UpdateGroup err: %v", err)
@@ -175,11 +167,11 @@ func updateGroup(_ context.Context, data *schema.ResourceData, i interface{}) di
 	return nil
 }
 
-func deleteGroup(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func deleteGroup(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := tenantScopedClient(i.(Client), data)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteGroup(id)
+	resp, faErrs, err := client.FAClient.DeleteGroupWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -209,12 +209,12 @@ func resourceIDPApple() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("identity provider", listImportIdentityProviders("fusionauth_idp_apple"), false),
 		},
 	}
 }
 
-func createIDPApple(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createIDPApple(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildIDPApple(data)
 
 	b, err := json.Marshal(o)
@@ -224,9 +224,9 @@ func createIDPApple(_ context.Context, data *schema.ResourceData, i interface{})
 
 	client := i.(Client)
 
-	bb, err := createIdentityProvider(b, client, "")
+	bb, err := createIdentityProvider(ctx, b, client, "")
 	if err != nil {
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPApple(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
@@ -238,9 +238,9 @@ func createIDPApple(_ context.Context, data *schema.ResourceData, i interface{})
 	return nil
 }
 
-func readIDPApple(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readIDPApple(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
+	b, err := readIdentityProvider(ctx, data.Id(), client)
 	if err != nil {
 		if err.Error() == NotFoundError {
 			data.SetId("")
@@ -255,7 +255,7 @@ func readIDPApple(_ context.Context, data *schema.ResourceData, i interface{}) d
 	return buildResourceFromIDPApple(ipb.IdentityProvider, data)
 }
 
-func updateIDPApple(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateIDPApple(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildIDPApple(data)
 
 	b, err := json.Marshal(o)
@@ -264,12 +264,12 @@ func updateIDPApple(_ context.Context, data *schema.ResourceData, i interface{})
 	}
 
 	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
+	bb, err := updateIdentityProvider(ctx, b, data.Id(), client)
 	if err != nil {
 		if data.HasChange("linking_strategy") && strings.Contains(err.Error(), "unexpected status code: 400(") {
 			return identityProviderLinkingStrategyUpdateWarning()
 		}
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPApple(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
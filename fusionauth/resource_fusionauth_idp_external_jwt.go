@@ -217,12 +217,12 @@ func resourceIDPExternalJWT() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("identity provider", listImportIdentityProviders("fusionauth_idp_external_jwt"), false),
 		},
 	}
 }
 
-func createIDPExternalJWT(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createIDPExternalJWT(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildIDPExternalJWT(data)
 
 	b, err := json.Marshal(o)
@@ -232,9 +232,9 @@ func createIDPExternalJWT(_ context.Context, data *schema.ResourceData, i interf
 
 	client := i.(Client)
 
-	bb, err := createIdentityProvider(b, client, data.Get("idp_id").(string))
+	bb, err := createIdentityProvider(ctx, b, client, data.Get("idp_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPExternalJWT(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
@@ -246,9 +246,9 @@ func createIDPExternalJWT(_ context.Context, data *schema.ResourceData, i interf
 	return nil
 }
 
-func readIDPExternalJWT(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readIDPExternalJWT(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
+	b, err := readIdentityProvider(ctx, data.Id(), client)
 	if err != nil {
 		if err.Error() == NotFoundError {
 			data.SetId("")
@@ -263,7 +263,7 @@ func readIDPExternalJWT(_ context.Context, data *schema.ResourceData, i interfac
 	return buildResourceDataFromIDPExternalJWT(data, ipb.IdentityProvider)
 }
 
-func updateIDPExternalJWT(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateIDPExternalJWT(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildIDPExternalJWT(data)
 
 	b, err := json.Marshal(o)
@@ -272,12 +272,12 @@ func updateIDPExternalJWT(_ context.Context, data *schema.ResourceData, i interf
 	}
 
 	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
+	bb, err := updateIdentityProvider(ctx, b, data.Id(), client)
 	if err != nil {
 		if data.HasChange("linking_strategy") && strings.Contains(err.Error(), "unexpected status code: 400(") {
 			return identityProviderLinkingStrategyUpdateWarning()
 		}
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPExternalJWT(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
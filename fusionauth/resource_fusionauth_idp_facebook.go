@@ -210,12 +210,12 @@ func resourceIDPFacebook() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("identity provider", listImportIdentityProviders("fusionauth_idp_facebook"), false),
 		},
 	}
 }
 
-func createIDPFacebook(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createIDPFacebook(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	fbIDP := buildIDPFacebook(data)
 	b, err := json.Marshal(fbIDP)
 	if err != nil {
@@ -223,9 +223,9 @@ func createIDPFacebook(_ context.Context, data *schema.ResourceData, i interface
 	}
 
 	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, "")
+	bb, err := createIdentityProvider(ctx, b, client, "")
 	if err != nil {
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPFacebook(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &fbIDP)
@@ -237,9 +237,9 @@ func createIDPFacebook(_ context.Context, data *schema.ResourceData, i interface
 	return buildResourceFromIDPFacebook(fbIDP.IdentityProvider, data)
 }
 
-func readIDPFacebook(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readIDPFacebook(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
+	b, err := readIdentityProvider(ctx, data.Id(), client)
 	if err != nil {
 		if err.Error() == NotFoundError {
 			data.SetId("")
@@ -257,7 +257,7 @@ func readIDPFacebook(_ context.Context, data *schema.ResourceData, i interface{}
 	return buildResourceFromIDPFacebook(ipb.IdentityProvider, data)
 }
 
-func updateIDPFacebook(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateIDPFacebook(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	fbIDP := buildIDPFacebook(data)
 	b, err := json.Marshal(fbIDP)
 	if err != nil {
@@ -265,12 +265,12 @@ func updateIDPFacebook(_ context.Context, data *schema.ResourceData, i interface
 	}
 
 	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
+	bb, err := updateIdentityProvider(ctx, b, data.Id(), client)
 	if err != nil {
 		if data.HasChange("linking_strategy") && strings.Contains(err.Error(), "unexpected status code: 400(") {
 			return identityProviderLinkingStrategyUpdateWarning()
 		}
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPFacebook(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &fbIDP)
@@ -257,7 +257,7 @@ type=standard`,
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("identity provider", listImportIdentityProviders("fusionauth_idp_google"), false),
 		},
 	}
 }
@@ -336,7 +336,7 @@ func getStringOrDefault(m map[string]interface{}, key string, defaultValue strin
 	return defaultValue
 }
 
-func createIDPGoogle(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createIDPGoogle(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildIDPGoogle(data)
 
 	b, err := json.Marshal(o)
@@ -346,9 +346,9 @@ func createIDPGoogle(_ context.Context, data *schema.ResourceData, i interface{}
 
 	client := i.(Client)
 
-	bb, err := createIdentityProvider(b, client, "")
+	bb, err := createIdentityProvider(ctx, b, client, "")
 	if err != nil {
-		return diag.FromErr(err)
+		return responseErrorDiags(err, newIDPGoogle(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
@@ -360,9 +360,9 @@ func createIDPGoogle(_ context.Context, data *schema.ResourceData, i interface{}
 	return nil
 }
 
-func readIDPGoogle(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readIDPGoogle(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
+	b, err := readIdentityProvider(ctx, data.Id(), client)
 	if err != nil {
 		if err.Error() == NotFoundError {
 			data.SetId("")
@@ -463,7 +463,7 @@ func buildResourceFromIDPGoogle(o fusionauth.GoogleIdentityProvider, data *schem
 	return nil
 }
 
-func updateIDPGoogle(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateIDPGoogle(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildIDPGoogle(data)
 
 	b, err := json.Marshal(o)
@@ -472,12 +472,12 @@ func updateIDPGoogle(_ context.Context, data *schema.ResourceData, i interface{}
 	}
 
 	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
+	bb, err := updateIdentityProvider(ctx, b, data.Id(), client)
 	if err != nil {
 		if data.HasChange("linking_strategy") && strings.Contains(err.Error(), "unexpected status code: 400(") {
 			return identityProviderLinkingStrategyUpdateWarning()
 		}
-		return diag.FromErr(err)
+		return responseErrorDiags(err, newIDPGoogle(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
@@ -189,12 +189,12 @@ func resourceIDPLinkedIn() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("identity provider", listImportIdentityProviders("fusionauth_idp_linkedin"), false),
 		},
 	}
 }
 
-func createIDPLinkedIn(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createIDPLinkedIn(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	linkedInIDP := buildIDPLinkedIn(data)
 	b, err := json.Marshal(linkedInIDP)
 	if err != nil {
@@ -202,9 +202,9 @@ func createIDPLinkedIn(_ context.Context, data *schema.ResourceData, i interface
 	}
 
 	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, "")
+	bb, err := createIdentityProvider(ctx, b, client, "")
 	if err != nil {
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPLinkedIn(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &linkedInIDP)
@@ -216,9 +216,9 @@ func createIDPLinkedIn(_ context.Context, data *schema.ResourceData, i interface
 	return buildResourceFromIDPLinkedIn(linkedInIDP.IdentityProvider, data)
 }
 
-func readIDPLinkedIn(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readIDPLinkedIn(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
+	b, err := readIdentityProvider(ctx, data.Id(), client)
 	if err != nil {
 		if err.Error() == NotFoundError {
 			data.SetId("")
@@ -236,7 +236,7 @@ func readIDPLinkedIn(_ context.Context, data *schema.ResourceData, i interface{}
 	return buildResourceFromIDPLinkedIn(ipb.IdentityProvider, data)
 }
 
-func updateIDPLinkedIn(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateIDPLinkedIn(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	linkedInIDP := buildIDPLinkedIn(data)
 	b, err := json.Marshal(linkedInIDP)
 	if err != nil {
@@ -244,12 +244,12 @@ func updateIDPLinkedIn(_ context.Context, data *schema.ResourceData, i interface
 	}
 
 	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
+	bb, err := updateIdentityProvider(ctx, b, data.Id(), client)
 	if err != nil {
 		if data.HasChange("linking_strategy") && strings.Contains(err.Error(), "unexpected status code: 400(") {
 			return identityProviderLinkingStrategyUpdateWarning()
 		}
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPLinkedIn(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &linkedInIDP)
@@ -275,7 +275,7 @@ func newIDPOpenIDConnect() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("identity provider", listImportIdentityProviders("fusionauth_idp_open_id_connect"), false),
 		},
 	}
 }
@@ -351,7 +351,7 @@ func buildOpenIDAppConfig(key string, data *schema.ResourceData) map[string]inte
 	return m
 }
 
-func createOpenIDConnect(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createOpenIDConnect(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildOpenIDConnect(data)
 
 	b, err := json.Marshal(o)
@@ -360,9 +360,9 @@ func createOpenIDConnect(_ context.Context, data *schema.ResourceData, i interfa
 	}
 
 	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, data.Get("idp_id").(string))
+	bb, err := createIdentityProvider(ctx, b, client, data.Get("idp_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return responseErrorDiags(err, newIDPOpenIDConnect(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
@@ -374,9 +374,9 @@ func createOpenIDConnect(_ context.Context, data *schema.ResourceData, i interfa
 	return nil
 }
 
-func readOpenIDConnect(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readOpenIDConnect(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
+	b, err := readIdentityProvider(ctx, data.Id(), client)
 	if err != nil {
 		if err.Error() == NotFoundError {
 			data.SetId("")
@@ -496,7 +496,7 @@ func buildResourceFromOpenIDConnect(o fusionauth.OpenIdConnectIdentityProvider,
 	return nil
 }
 
-func updateOpenIDConnect(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateOpenIDConnect(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildOpenIDConnect(data)
 
 	b, err := json.Marshal(o)
@@ -505,12 +505,12 @@ func updateOpenIDConnect(_ context.Context, data *schema.ResourceData, i interfa
 	}
 
 	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
+	bb, err := updateIdentityProvider(ctx, b, data.Id(), client)
 	if err != nil {
 		if data.HasChange("linking_strategy") && strings.Contains(err.Error(), "unexpected status code: 400(") {
 			return identityProviderLinkingStrategyUpdateWarning()
 		}
-		return diag.FromErr(err)
+		return responseErrorDiags(err, newIDPOpenIDConnect(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
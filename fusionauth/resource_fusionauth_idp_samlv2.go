@@ -351,12 +351,12 @@ func resourceIDPSAMLv2() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("identity provider", listImportIdentityProviders("fusionauth_idp_saml_v2"), false),
 		},
 	}
 }
 
-func createIDPSAMLv2(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createIDPSAMLv2(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildIDPSAMLv2(data)
 
 	b, err := json.Marshal(o)
@@ -365,9 +365,9 @@ func createIDPSAMLv2(_ context.Context, data *schema.ResourceData, i interface{}
 	}
 
 	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, data.Get("idp_id").(string))
+	bb, err := createIdentityProvider(ctx, b, client, data.Get("idp_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPSAMLv2(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
@@ -378,9 +378,9 @@ func createIDPSAMLv2(_ context.Context, data *schema.ResourceData, i interface{}
 	data.SetId(o.IdentityProvider.Id)
 	return nil
 }
-func readIDPSAMLv2(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readIDPSAMLv2(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
+	b, err := readIdentityProvider(ctx, data.Id(), client)
 	if err != nil {
 		if err.Error() == NotFoundError {
 			data.SetId("")
@@ -395,7 +395,7 @@ func readIDPSAMLv2(_ context.Context, data *schema.ResourceData, i interface{})
 	return buildResourceDataFromIDPSAMLv2(data, ipb.IdentityProvider)
 }
 
-func updateIDPSAMLv2(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateIDPSAMLv2(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildIDPSAMLv2(data)
 
 	b, err := json.Marshal(o)
@@ -404,12 +404,12 @@ func updateIDPSAMLv2(_ context.Context, data *schema.ResourceData, i interface{}
 	}
 
 	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
+	bb, err := updateIdentityProvider(ctx, b, data.Id(), client)
 	if err != nil {
 		if data.HasChange("linking_strategy") && strings.Contains(err.Error(), "unexpected status code: 400(") {
 			return identityProviderLinkingStrategyUpdateWarning()
 		}
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPSAMLv2(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
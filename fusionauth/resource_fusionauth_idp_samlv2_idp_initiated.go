@@ -213,12 +213,12 @@ func resourceIDPSAMLv2IdPInitiated() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("identity provider", listImportIdentityProviders("fusionauth_idp_saml_v2_idp_initated"), false),
 		},
 	}
 }
 
-func createIDPSAMLv2IdPInitiated(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createIDPSAMLv2IdPInitiated(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildIDPSAMLv2IdPInitiated(data)
 
 	b, err := json.Marshal(o)
@@ -227,9 +227,9 @@ func createIDPSAMLv2IdPInitiated(_ context.Context, data *schema.ResourceData, i
 	}
 
 	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, data.Get("idp_id").(string))
+	bb, err := createIdentityProvider(ctx, b, client, data.Get("idp_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPSAMLv2IdPInitiated(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
@@ -241,9 +241,9 @@ func createIDPSAMLv2IdPInitiated(_ context.Context, data *schema.ResourceData, i
 	return nil
 }
 
-func readIDPSAMLv2IdPInitiated(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readIDPSAMLv2IdPInitiated(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
+	b, err := readIdentityProvider(ctx, data.Id(), client)
 	if err != nil {
 		if err.Error() == NotFoundError {
 			data.SetId("")
@@ -258,7 +258,7 @@ func readIDPSAMLv2IdPInitiated(_ context.Context, data *schema.ResourceData, i i
 	return buildResourceDataFromIDPSAMLv2IdPInitiated(data, ipb.IdentityProvider)
 }
 
-func updateIDPSAMLv2IdPInitiated(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateIDPSAMLv2IdPInitiated(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildIDPSAMLv2IdPInitiated(data)
 
 	b, err := json.Marshal(o)
@@ -267,12 +267,12 @@ func updateIDPSAMLv2IdPInitiated(_ context.Context, data *schema.ResourceData, i
 	}
 
 	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
+	bb, err := updateIdentityProvider(ctx, b, data.Id(), client)
 	if err != nil {
 		if data.HasChange("linking_strategy") && strings.Contains(err.Error(), "unexpected status code: 400(") {
 			return identityProviderLinkingStrategyUpdateWarning()
 		}
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPSAMLv2IdPInitiated(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
@@ -191,12 +191,12 @@ func resourceIDPSonyPSN() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("identity provider", listImportIdentityProviders("fusionauth_idp_sony_psn"), false),
 		},
 	}
 }
 
-func createIDPSonyPSN(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createIDPSonyPSN(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildIDPSonyPSN(data)
 
 	b, err := json.Marshal(o)
@@ -205,9 +205,9 @@ func createIDPSonyPSN(_ context.Context, data *schema.ResourceData, i interface{
 	}
 
 	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, data.Get("idp_id").(string))
+	bb, err := createIdentityProvider(ctx, b, client, data.Get("idp_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPSonyPSN(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
@@ -219,9 +219,9 @@ func createIDPSonyPSN(_ context.Context, data *schema.ResourceData, i interface{
 	return nil
 }
 
-func readIDPSonyPSN(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readIDPSonyPSN(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
+	b, err := readIdentityProvider(ctx, data.Id(), client)
 	if err != nil {
 		if err.Error() == NotFoundError {
 			data.SetId("")
@@ -236,7 +236,7 @@ func readIDPSonyPSN(_ context.Context, data *schema.ResourceData, i interface{})
 	return buildResourceDataFromIDPSonyPSN(data, ipb.IdentityProvider)
 }
 
-func updateIDPSonyPSN(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateIDPSonyPSN(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildIDPSonyPSN(data)
 
 	b, err := json.Marshal(o)
@@ -244,12 +244,12 @@ func updateIDPSonyPSN(_ context.Context, data *schema.ResourceData, i interface{
 		return diag.FromErr(err)
 	}
 	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
+	bb, err := updateIdentityProvider(ctx, b, data.Id(), client)
 	if err != nil {
 		if data.HasChange("linking_strategy") && strings.Contains(err.Error(), "unexpected status code: 400(") {
 			return identityProviderLinkingStrategyUpdateWarning()
 		}
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPSonyPSN(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
@@ -205,12 +205,12 @@ func resourceIDPSteam() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("identity provider", listImportIdentityProviders("fusionauth_idp_steam"), false),
 		},
 	}
 }
 
-func createIDPSteam(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createIDPSteam(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildIDPSteam(data)
 
 	b, err := json.Marshal(o)
@@ -219,9 +219,9 @@ func createIDPSteam(_ context.Context, data *schema.ResourceData, i interface{})
 	}
 
 	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, data.Get("idp_id").(string))
+	bb, err := createIdentityProvider(ctx, b, client, data.Get("idp_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPSteam(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
@@ -233,9 +233,9 @@ func createIDPSteam(_ context.Context, data *schema.ResourceData, i interface{})
 	return nil
 }
 
-func readIDPSteam(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readIDPSteam(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
+	b, err := readIdentityProvider(ctx, data.Id(), client)
 	if err != nil {
 		if err.Error() == NotFoundError {
 			data.SetId("")
@@ -250,7 +250,7 @@ func readIDPSteam(_ context.Context, data *schema.ResourceData, i interface{}) d
 	return buildResourceDataFromIDPSteam(data, ipb.IdentityProvider)
 }
 
-func updateIDPSteam(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateIDPSteam(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildIDPSteam(data)
 
 	b, err := json.Marshal(o)
@@ -259,12 +259,12 @@ func updateIDPSteam(_ context.Context, data *schema.ResourceData, i interface{})
 	}
 
 	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
+	bb, err := updateIdentityProvider(ctx, b, data.Id(), client)
 	if err != nil {
 		if data.HasChange("linking_strategy") && strings.Contains(err.Error(), "unexpected status code: 400(") {
 			return identityProviderLinkingStrategyUpdateWarning()
 		}
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPSteam(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
@@ -191,12 +191,12 @@ func resourceIDPXbox() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("identity provider", listImportIdentityProviders("fusionauth_idp_xbox"), false),
 		},
 	}
 }
 
-func createIDPXbox(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createIDPXbox(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildIDPXbox(data)
 
 	b, err := json.Marshal(o)
@@ -205,9 +205,9 @@ func createIDPXbox(_ context.Context, data *schema.ResourceData, i interface{})
 	}
 
 	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, data.Get("idp_id").(string))
+	bb, err := createIdentityProvider(ctx, b, client, data.Get("idp_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPXbox(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
@@ -219,9 +219,9 @@ func createIDPXbox(_ context.Context, data *schema.ResourceData, i interface{})
 	return nil
 }
 
-func readIDPXbox(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readIDPXbox(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
+	b, err := readIdentityProvider(ctx, data.Id(), client)
 	if err != nil {
 		if err.Error() == NotFoundError {
 			data.SetId("")
@@ -236,7 +236,7 @@ func readIDPXbox(_ context.Context, data *schema.ResourceData, i interface{}) di
 	return buildResourceDataFromIDPXbox(data, ipb.IdentityProvider)
 }
 
-func updateIDPXbox(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateIDPXbox(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	o := buildIDPXbox(data)
 
 	b, err := json.Marshal(o)
@@ -245,12 +245,12 @@ func updateIDPXbox(_ context.Context, data *schema.ResourceData, i interface{})
 	}
 
 	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
+	bb, err := updateIdentityProvider(ctx, b, data.Id(), client)
 	if err != nil {
 		if data.HasChange("linking_strategy") && strings.Contains(err.Error(), "unexpected status code: 400(") {
 			return identityProviderLinkingStrategyUpdateWarning()
 		}
-		return diag.FromErr(err)
+		return responseErrorDiags(err, resourceIDPXbox(), "identityProvider")
 	}
 
 	err = json.Unmarshal(bb, &o)
@@ -10,13 +10,13 @@ import (
 )
 
 func resourceImportedKey() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: createImportedKey,
-		ReadContext: func(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-			return keyRead(data, buildResourceDataFromImportedKey, i)
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			return keyRead(ctx, data, buildResourceDataFromImportedKey, i)
 		},
-		UpdateContext: func(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-			return keyUpdate(data, buildImportedKey, i)
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			return keyUpdate(ctx, data, buildImportedKey, i)
 		},
 		DeleteContext: keyDelete,
 		Schema: map[string]*schema.Schema{
@@ -102,12 +102,17 @@ func resourceImportedKey() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("key", listExportKeys, false),
 		},
 	}
+
+	addWriteOnlySchema(r.Schema, "private_key", "")
+	addWriteOnlySchema(r.Schema, "secret", "")
+
+	return r
 }
 
-func createImportedKey(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createImportedKey(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	l := buildImportedKey(data)
 
@@ -116,7 +121,7 @@ func createImportedKey(_ context.Context, data *schema.ResourceData, i interface
 		keyID = a.(string)
 	}
 
-	resp, faErrs, err := client.FAClient.ImportKey(keyID, fusionauth.KeyRequest{
+	resp, faErrs, err := client.FAClient.ImportKeyWithContext(ctx, keyID, fusionauth.KeyRequest{
 		Key: l,
 	})
 	if err != nil {
@@ -137,8 +142,8 @@ func buildImportedKey(data *schema.ResourceData) fusionauth.Key {
 		Kid:         data.Get("kid").(string),
 		Name:        data.Get("name").(string),
 		PublicKey:   data.Get("public_key").(string),
-		PrivateKey:  data.Get("private_key").(string),
-		Secret:      data.Get("secret").(string),
+		PrivateKey:  sensitiveString(data, "private_key"),
+		Secret:      sensitiveString(data, "secret"),
 		Type:        fusionauth.KeyType(data.Get("type").(string)),
 	}
 }
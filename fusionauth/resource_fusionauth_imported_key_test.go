@@ -48,7 +48,7 @@ func TestAccFusionauthImportedKey_Secret(t *testing.T) {
 	resourceName := randString10()
 	tfResourcePath := fmt.Sprintf("fusionauth_imported_key.test_%s", resourceName)
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckFusionauthImportedKeyDestroy,
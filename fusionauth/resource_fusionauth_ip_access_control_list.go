@@ -0,0 +1,342 @@
+package fusionauth
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ipAccessControlWildcard is the start and end address of the entry that
+// sets the action for addresses no other entry matches.
+const ipAccessControlWildcard = "*"
+
+func newIPAccessControlList() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createIPAccessControlList,
+		ReadContext:   readIPAccessControlList,
+		UpdateContext: updateIPAccessControlList,
+		DeleteContext: deleteIPAccessControlList,
+		CustomizeDiff: validateConfig(validateIPAccessControlEntries),
+		Schema: map[string]*schema.Schema{
+			"ip_access_control_list_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The Id to use for the new IP Access Control List. If not specified a secure random UUID will be generated.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique name of the IP Access Control List.",
+			},
+			"data": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "An object that can hold any information about the IP Access Control List that should be persisted. Must be a JSON string.",
+				DiffSuppressFunc: diffSuppressJSON,
+				ValidateFunc:     validation.StringIsJSON,
+			},
+			"entry": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The IP ranges and the action taken for requests from them, in order. Exactly one entry must have the start_ip_address *, which sets the action for every address no other entry matches.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The action taken for requests from the range. The possible values are Allow and Block.",
+							ValidateFunc: validation.StringInSlice([]string{"Allow", "Block"}, false),
+						},
+						"start_ip_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The first IP address of the range, a CIDR block such as 10.0.0.0/8 for the whole block, or * for every address.",
+							ValidateFunc: validateIPAccessControlAddress,
+						},
+						"end_ip_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The last IP address of the range, inclusive. Defaults to start_ip_address, and must be left unset when start_ip_address is a CIDR block or *.",
+							ValidateFunc: validation.IsIPAddress,
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importByName("IP access control list", listExportIPAccessControlLists, false),
+		},
+	}
+}
+
+// validateIPAccessControlAddress checks that v is an IP address, a CIDR block
+// or the wildcard.
+func validateIPAccessControlAddress(i interface{}, k string) (warnings []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if v == ipAccessControlWildcard || net.ParseIP(v) != nil {
+		return nil, nil
+	}
+	if _, _, err := net.ParseCIDR(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be an IP address, a CIDR block or *, got %s", k, v)}
+	}
+
+	return nil, nil
+}
+
+// validateIPAccessControlEntries checks that each entry's range is well
+// formed, and that exactly one entry is the wildcard.
+func validateIPAccessControlEntries(config cty.Value) error {
+	entries := configValue(config, cty.GetAttrPath("entry"))
+	if !entries.IsKnown() || entries.IsNull() {
+		return nil
+	}
+
+	wildcards, unknown := 0, false
+	for i := 0; i < entries.LengthInt(); i++ {
+		path := cty.GetAttrPath("entry").IndexInt(i)
+		startPath, endPath := path.GetAttr("start_ip_address"), path.GetAttr("end_ip_address")
+
+		start, startKnown := configString(config, startPath)
+		end, endSet := configString(config, endPath)
+		switch {
+		case !startKnown:
+			// A start that isn't known yet may still be the wildcard.
+			unknown = unknown || !configValue(config, startPath).IsKnown()
+		case start == ipAccessControlWildcard:
+			wildcards++
+			if endSet && end != "" {
+				return endPath.NewErrorf("end_ip_address must be left unset when start_ip_address is *")
+			}
+		case strings.Contains(start, "/"):
+			if endSet && end != "" {
+				return endPath.NewErrorf("end_ip_address must be left unset when start_ip_address is the CIDR block %s", start)
+			}
+		case endSet && end != "":
+			startIP, endIP := net.ParseIP(start), net.ParseIP(end)
+			if startIP == nil || endIP == nil {
+				continue
+			}
+			if (startIP.To4() == nil) != (endIP.To4() == nil) {
+				return endPath.NewErrorf("end_ip_address %s and start_ip_address %s must both be IPv4 or both be IPv6 addresses", end, start)
+			}
+			if bytes.Compare(startIP.To16(), endIP.To16()) > 0 {
+				return endPath.NewErrorf("end_ip_address %s must not come before start_ip_address %s", end, start)
+			}
+		}
+	}
+
+	switch {
+	case wildcards == 0 && !unknown:
+		return cty.GetAttrPath("entry").NewErrorf("exactly one entry must have the start_ip_address *, to set the action for addresses no other entry matches")
+	case wildcards > 1:
+		return cty.GetAttrPath("entry").NewErrorf("exactly one entry must have the start_ip_address *, found %d", wildcards)
+	}
+
+	return nil
+}
+
+// ipAccessControlRange returns the start and end address FusionAuth stores
+// for an entry, expanding CIDR blocks and defaulting the end to the start.
+func ipAccessControlRange(start, end string) (string, string) {
+	if start == ipAccessControlWildcard {
+		return ipAccessControlWildcard, ipAccessControlWildcard
+	}
+	if _, block, err := net.ParseCIDR(start); err == nil {
+		first := block.IP
+		last := make(net.IP, len(first))
+		for i := range first {
+			last[i] = first[i] | ^block.Mask[i]
+		}
+		return first.String(), last.String()
+	}
+	if end == "" {
+		return start, start
+	}
+
+	return start, end
+}
+
+func buildIPAccessControlList(data *schema.ResourceData) fusionauth.IPAccessControlList {
+	resourceData, _ := jsonStringToMapStringInterface(data.Get("data").(string))
+	acl := fusionauth.IPAccessControlList{
+		Data: resourceData,
+		Id:   data.Get("ip_access_control_list_id").(string),
+		Name: data.Get("name").(string),
+	}
+
+	for _, e := range data.Get("entry").([]interface{}) {
+		entry := e.(map[string]interface{})
+		start, end := ipAccessControlRange(entry["start_ip_address"].(string), entry["end_ip_address"].(string))
+		acl.Entries = append(acl.Entries, fusionauth.IPAccessControlEntry{
+			Action:         fusionauth.IPAccessControlEntryAction(entry["action"].(string)),
+			StartIPAddress: start,
+			EndIPAddress:   end,
+		})
+	}
+
+	return acl
+}
+
+// flattenIPAccessControlEntries returns the entries as they are configured.
+// An entry that still covers the range of the prior entry at its position
+// keeps the prior's form, so a CIDR block doesn't show up as a diff. Other
+// entries leave the end address unset when it is implied by the start.
+func flattenIPAccessControlEntries(entries []fusionauth.IPAccessControlEntry, prior []interface{}) []interface{} {
+	flattened := make([]interface{}, 0, len(entries))
+	for i, e := range entries {
+		entry := map[string]interface{}{
+			"action":           string(e.Action),
+			"start_ip_address": e.StartIPAddress,
+			"end_ip_address":   e.EndIPAddress,
+		}
+		if e.EndIPAddress == e.StartIPAddress {
+			entry["end_ip_address"] = ""
+		}
+
+		if i < len(prior) {
+			p := prior[i].(map[string]interface{})
+			start, end := ipAccessControlRange(p["start_ip_address"].(string), p["end_ip_address"].(string))
+			if start == e.StartIPAddress && end == e.EndIPAddress {
+				entry["start_ip_address"] = p["start_ip_address"]
+				entry["end_ip_address"] = p["end_ip_address"]
+			}
+		}
+		flattened = append(flattened, entry)
+	}
+
+	return flattened
+}
+
+func createIPAccessControlList(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	acl := buildIPAccessControlList(data)
+
+	resp, faErrs, err := client.FAClient.CreateIPAccessControlListWithContext(ctx, acl.Id, fusionauth.IPAccessControlListRequest{
+		IpAccessControlList: acl,
+	})
+	if err != nil {
+		return diag.Errorf("CreateIPAccessControlList err: %v", err)
+	}
+	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(resp.IpAccessControlList.Id)
+	return readIPAccessControlList(ctx, data, i)
+}
+
+func readIPAccessControlList(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, err := client.FAClient.RetrieveIPAccessControlListWithContext(ctx, data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		data.SetId("")
+		return nil
+	}
+	if err := checkResponse(resp.StatusCode, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	acl := resp.IpAccessControlList
+	if err := data.Set("ip_access_control_list_id", acl.Id); err != nil {
+		return diag.Errorf("ipAccessControlList.ip_access_control_list_id: %s", err.Error())
+	}
+	if err := data.Set("name", acl.Name); err != nil {
+		return diag.Errorf("ipAccessControlList.name: %s", err.Error())
+	}
+	dataJSON, diags := mapStringInterfaceToJSONString(acl.Data)
+	if diags != nil {
+		return diags
+	}
+	if err := data.Set("data", dataJSON); err != nil {
+		return diag.Errorf("ipAccessControlList.data: %s", err.Error())
+	}
+	entries := flattenIPAccessControlEntries(acl.Entries, data.Get("entry").([]interface{}))
+	if err := data.Set("entry", entries); err != nil {
+		return diag.Errorf("ipAccessControlList.entry: %s", err.Error())
+	}
+
+	return nil
+}
+
+func updateIPAccessControlList(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	acl := buildIPAccessControlList(data)
+
+	resp, faErrs, err := client.FAClient.UpdateIPAccessControlListWithContext(ctx, data.Id(), fusionauth.IPAccessControlListRequest{
+		IpAccessControlList: acl,
+	})
+	if err != nil {
+		return diag.Errorf("UpdateIPAccessControlList err: %v", err)
+	}
+	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readIPAccessControlList(ctx, data, i)
+}
+
+func deleteIPAccessControlList(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, faErrs, err := client.FAClient.DeleteIPAccessControlListWithContext(ctx, data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// searchIPAccessControlLists returns the IP access control lists whose name
+// matches name, or every list when name is empty. FusionAuth has no API that
+// lists them all, so they are searched for page by page.
+func searchIPAccessControlLists(ctx context.Context, client Client, name string) ([]fusionauth.IPAccessControlList, error) {
+	const pageSize = 100
+
+	var acls []fusionauth.IPAccessControlList
+	for {
+		resp, faErrs, err := client.FAClient.SearchIPAccessControlListsWithContext(ctx, fusionauth.IPAccessControlListSearchRequest{
+			Search: fusionauth.IPAccessControlListSearchCriteria{
+				BaseSearchCriteria: fusionauth.BaseSearchCriteria{
+					NumberOfResults: pageSize,
+					StartRow:        len(acls),
+				},
+				Name: name,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := checkResponse(resp.StatusCode, faErrs); err != nil {
+			return nil, err
+		}
+
+		acls = append(acls, resp.IpAccessControlLists...)
+		if len(resp.IpAccessControlLists) < pageSize || int64(len(acls)) >= resp.Total {
+			return acls, nil
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Test_ipAccessControlRange(t *testing.T) {
+	tests := []struct {
+		start, end         string
+		wantStart, wantEnd string
+	}{
+		{"*", "", "*", "*"},
+		{"10.0.0.1", "", "10.0.0.1", "10.0.0.1"},
+		{"10.0.0.1", "10.0.0.9", "10.0.0.1", "10.0.0.9"},
+		{"10.1.2.3/16", "", "10.1.0.0", "10.1.255.255"},
+		{"2001:db8::/126", "", "2001:db8::", "2001:db8::3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.start, func(t *testing.T) {
+			start, end := ipAccessControlRange(tt.start, tt.end)
+			if start != tt.wantStart || end != tt.wantEnd {
+				t.Errorf("ipAccessControlRange(%q, %q) = %s, %s, want %s, %s", tt.start, tt.end, start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func Test_ipAccessControlList_lifecycle(t *testing.T) {
+	fake, client := newFakeFusionAuthClient(t)
+	ctx := context.Background()
+
+	entries := []interface{}{
+		map[string]interface{}{"action": "Block", "start_ip_address": "*"},
+		map[string]interface{}{"action": "Allow", "start_ip_address": "10.0.0.0/8"},
+		map[string]interface{}{"action": "Allow", "start_ip_address": "192.168.1.10", "end_ip_address": "192.168.1.20"},
+	}
+	data := schema.TestResourceDataRaw(t, newIPAccessControlList().Schema, map[string]interface{}{
+		"name":  "Office",
+		"entry": entries,
+	})
+	if diags := createIPAccessControlList(ctx, data, client); diags.HasError() {
+		t.Fatalf("createIPAccessControlList: %v", diags)
+	}
+
+	stored, ok := fake.object("ipAccessControlList", data.Id())
+	if !ok {
+		t.Fatal("the IP access control list wasn't created")
+	}
+	cidr := stored["entries"].([]interface{})[1].(map[string]interface{})
+	if cidr["startIPAddress"] != "10.0.0.0" || cidr["endIPAddress"] != "10.255.255.255" {
+		t.Errorf("CIDR entry was sent as %v", cidr)
+	}
+
+	// Read back, the entries keep the form they were configured in.
+	for i, e := range entries {
+		want := e.(map[string]interface{})
+		for _, k := range []string{"action", "start_ip_address", "end_ip_address"} {
+			if got := data.Get(fmt.Sprintf("entry.%d.%s", i, k)); got != want[k] && !(want[k] == nil && got == "") {
+				t.Errorf("entry.%d.%s = %v, want %v", i, k, got, want[k])
+			}
+		}
+	}
+
+	lookup := schema.TestResourceDataRaw(t, dataSourceIPAccessControlList().Schema, map[string]interface{}{"name": "Office"})
+	if diags := dataSourceIPAccessControlListRead(ctx, lookup, client); diags.HasError() {
+		t.Fatalf("dataSourceIPAccessControlListRead: %v", diags)
+	}
+	if lookup.Id() != data.Id() || lookup.Get("entry.1.end_ip_address") != "10.255.255.255" {
+		t.Errorf("data source found %s with entries %v", lookup.Id(), lookup.Get("entry"))
+	}
+
+	if diags := deleteIPAccessControlList(ctx, data, client); diags.HasError() {
+		t.Fatalf("deleteIPAccessControlList: %v", diags)
+	}
+	if _, ok := fake.object("ipAccessControlList", data.Id()); ok {
+		t.Error("the IP access control list wasn't deleted")
+	}
+}
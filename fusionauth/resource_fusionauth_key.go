@@ -2,8 +2,10 @@ package fusionauth
 
 import (
 	"context"
+	"strings"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -12,13 +14,14 @@ import (
 func newKey() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: createKey,
-		ReadContext: func(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-			return keyRead(data, buildResourceDataFromKey, i)
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			return keyRead(ctx, data, buildResourceDataFromKey, i)
 		},
-		UpdateContext: func(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-			return keyUpdate(data, buildKey, i)
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			return keyUpdate(ctx, data, buildKey, i)
 		},
 		DeleteContext: keyDelete,
+		CustomizeDiff: validateConfig(validateKeyLength),
 		Schema: map[string]*schema.Schema{
 			"key_id": {
 				Type:         schema.TypeString,
@@ -69,11 +72,41 @@ func newKey() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("key", listExportKeys, false),
 		},
 	}
 }
 
+// validateKeyLength checks that RSA keys are generated with one of the key
+// lengths FusionAuth supports, and that EC keys, whose length follows from
+// the curve, aren't given a different one.
+func validateKeyLength(config cty.Value) error {
+	algorithm, ok := configString(config, cty.GetAttrPath("algorithm"))
+	if !ok {
+		return nil
+	}
+
+	lengthPath := cty.GetAttrPath("length")
+	length, lengthSet := configInt(config, lengthPath)
+
+	switch {
+	case strings.HasPrefix(algorithm, "RS"):
+		if !configSet(config, lengthPath) {
+			return lengthPath.NewErrorf("length is required for %s keys, and must be one of 2048, 3072 or 4096", algorithm)
+		}
+		if lengthSet && length != 2048 && length != 3072 && length != 4096 {
+			return lengthPath.NewErrorf("length must be one of 2048, 3072 or 4096 for %s keys, got %d", algorithm, length)
+		}
+	case strings.HasPrefix(algorithm, "ES"):
+		want := map[string]int64{"ES256": 256, "ES384": 384, "ES512": 521}[algorithm]
+		if lengthSet && length != want {
+			return lengthPath.NewErrorf("length must be %d for %s keys, or be left unset, got %d", want, algorithm, length)
+		}
+	}
+
+	return nil
+}
+
 func buildKey(data *schema.ResourceData) fusionauth.Key {
 	l := fusionauth.Key{
 		Algorithm: fusionauth.KeyAlgorithm(data.Get("algorithm").(string)),
@@ -84,7 +117,7 @@ func buildKey(data *schema.ResourceData) fusionauth.Key {
 	return l
 }
 
-func createKey(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createKey(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	l := buildKey(data)
 
@@ -93,7 +126,7 @@ func createKey(_ context.Context, data *schema.ResourceData, i interface{}) diag
 		keyID = a.(string)
 	}
 
-	resp, faErrs, err := client.FAClient.GenerateKey(keyID, fusionauth.KeyRequest{
+	resp, faErrs, err := client.FAClient.GenerateKeyWithContext(ctx, keyID, fusionauth.KeyRequest{
 		Key: l,
 	})
 	if err != nil {
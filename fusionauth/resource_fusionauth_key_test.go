@@ -21,7 +21,7 @@ func TestAccFusionauthKey_basic(t *testing.T) {
 	startLength, endLength := 2048, 4096
 	startIssuer, endIssuer := "FusionAuth", "FusionAuth Inc."
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckFusionauthKeyDestroy,
@@ -72,7 +72,7 @@ func TestAccFusionauthKey_SetID(t *testing.T) {
 	startLength, endLength := 2048, 4096
 	startIssuer, endIssuer := "FusionAuth", "FusionAuth Inc."
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckFusionauthKeyDestroy,
@@ -91,7 +91,7 @@ func newLambda() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("lambda", listExportLambdas, false),
 		},
 	}
 }
@@ -108,10 +108,10 @@ func buildLambda(data *schema.ResourceData) fusionauth.Lambda {
 	return l
 }
 
-func createLambda(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createLambda(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	l := buildLambda(data)
-	resp, faErrs, err := client.FAClient.CreateLambda(l.Id, fusionauth.LambdaRequest{
+	resp, faErrs, err := client.FAClient.CreateLambdaWithContext(ctx, l.Id, fusionauth.LambdaRequest{
 		Lambda: l,
 	})
 	if err != nil {
@@ -125,11 +125,11 @@ func createLambda(_ context.Context, data *schema.ResourceData, i interface{}) d
 	return nil
 }
 
-func readLambda(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readLambda(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.RetrieveLambda(id)
+	resp, faErrs, err := client.FAClient.RetrieveLambdaWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -162,11 +162,11 @@ func readLambda(_ context.Context, data *schema.ResourceData, i interface{}) dia
 	return nil
 }
 
-func updateLambda(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateLambda(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	l := buildLambda(data)
 
-	resp, faErrs, err := client.FAClient.UpdateLambda(data.Id(), fusionauth.LambdaRequest{
+	resp, faErrs, err := client.FAClient.UpdateLambdaWithContext(ctx, data.Id(), fusionauth.LambdaRequest{
 		Lambda: l,
 	})
 	if err != nil {
@@ -180,11 +180,11 @@ func updateLambda(_ context.Context, data *schema.ResourceData, i interface{}) d
 	return nil
 }
 
-func deleteLambda(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteLambda(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteLambda(id)
+	resp, faErrs, err := client.FAClient.DeleteLambdaWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
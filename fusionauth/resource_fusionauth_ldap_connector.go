@@ -122,7 +122,7 @@ func newLDAPConnector() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("connector", listImportConnectors(fusionauth.ConnectorType_LDAP), false),
 		},
 	}
 }
@@ -261,11 +261,11 @@ func updateLDAPConnector(ctx context.Context, data *schema.ResourceData, i inter
 	return nil
 }
 
-func deleteLDAPConnector(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteLDAPConnector(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteConnector(id)
+	resp, faErrs, err := client.FAClient.DeleteConnectorWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
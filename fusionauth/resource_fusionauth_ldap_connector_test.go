@@ -27,7 +27,7 @@ func TestAccLDAPConnector(t *testing.T) {
 	startSystemAccountPassword, endSystemAccountPassword := "ldap-secret-start", "ldap-secret-end"
 	startSecurityMethod, endSecurityMethod := "None", "LDAPS"
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckLDAPConnectorDestroy,
 		Steps: []resource.TestStep{
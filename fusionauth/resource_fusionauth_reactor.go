@@ -8,8 +8,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// reactorID is the made up id of the reactor resource, see createReactor.
+const reactorID = "fusion-auth-reactor-id"
+
 func newReactor() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: createReactor,
 		ReadContext:   readReactor,
 		UpdateContext: updateReactor,
@@ -29,21 +32,25 @@ func newReactor() *schema.Resource {
 			},
 		},
 	}
+
+	addWriteOnlySchema(r.Schema, "license", "")
+
+	return r
 }
 
 func buildReactor(data *schema.ResourceData) fusionauth.ReactorRequest {
 	reactor := fusionauth.ReactorRequest{
 		LicenseId: data.Get("license_id").(string),
-		License:   data.Get("license").(string),
+		License:   sensitiveString(data, "license"),
 	}
 	return reactor
 }
 
-func createReactor(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createReactor(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	reactor := buildReactor(data)
 
-	resp, faErrs, err := client.FAClient.ActivateReactor(reactor)
+	resp, faErrs, err := client.FAClient.ActivateReactorWithContext(ctx, reactor)
 	if err != nil {
 		return diag.Errorf("CreateReactor err: %v", err)
 	}
@@ -55,16 +62,16 @@ func createReactor(_ context.Context, data *schema.ResourceData, i interface{})
 	// Every terraform resource needs an id. Since none of the fusion auth reactor apis return
 	// the id of the reactor, we will need to make one up. There can be only one reactor
 	// so this should be safe.
-	data.SetId("fusion-auth-reactor-id")
+	data.SetId(reactorID)
 
 	return nil
 }
 
-func updateReactor(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateReactor(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	reactor := buildReactor(data)
 
-	resp, faErrs, err := client.FAClient.ActivateReactor(fusionauth.ReactorRequest{
+	resp, faErrs, err := client.FAClient.ActivateReactorWithContext(ctx, fusionauth.ReactorRequest{
 		LicenseId: reactor.LicenseId,
 		License:   reactor.License,
 	})
@@ -79,10 +86,10 @@ func updateReactor(_ context.Context, data *schema.ResourceData, i interface{})
 	return nil
 }
 
-func deleteReactor(_ context.Context, _ *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteReactor(ctx context.Context, _ *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	resp, err := client.FAClient.DeactivateReactor()
+	resp, err := client.FAClient.DeactivateReactorWithContext(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -94,10 +101,10 @@ func deleteReactor(_ context.Context, _ *schema.ResourceData, i interface{}) dia
 	return nil
 }
 
-func readReactor(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readReactor(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	resp, err := client.FAClient.RetrieveReactorStatus()
+	resp, err := client.FAClient.RetrieveReactorStatusWithContext(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
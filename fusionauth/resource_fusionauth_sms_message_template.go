@@ -54,7 +54,7 @@ func newSMSMessageTemplate() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("SMS message template", listImportSMSMessageTemplates, false),
 		},
 	}
 }
@@ -159,11 +159,11 @@ func updateSMSMessageTemplate(ctx context.Context, data *schema.ResourceData, i
 	return nil
 }
 
-func deleteSMSMessageTemplate(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteSMSMessageTemplate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteMessageTemplate(id)
+	resp, faErrs, err := client.FAClient.DeleteMessageTemplateWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -21,7 +21,7 @@ func TestAccSMSMessageTemplate(t *testing.T) {
 	startLocalizedFR, endLocalizedFR := "Votre code de vérification est $${code}", "Votre nouveau code de vérification est $${code}"
 	startLocalizedES, endLocalizedES := "Su código de verificación es $${code}", "Su nuevo código de verificación es $${code}"
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckSMSMessageTemplateDestroy,
 		Steps: []resource.TestStep{
@@ -2,6 +2,7 @@ package fusionauth
 
 import (
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -12,6 +13,15 @@ func newTenant() *schema.Resource {
 		ReadContext:   readTenant,
 		UpdateContext: updateTenant,
 		DeleteContext: deleteTenant,
+		CustomizeDiff: customdiff.Sequence(
+			requireServerVersion(
+				versionedAttribute{Attribute: "webauthn_configuration", MinVersion: "1.41.0"},
+				versionedAttribute{Attribute: "phone_configuration", MinVersion: "1.59.0"},
+				versionedAttribute{Attribute: "base_url", MinVersion: "1.68.0"},
+				versionedAttribute{Attribute: "client_risk_configuration", MinVersion: "1.68.0"},
+			),
+			validateConfig(validateSCIMConfiguration, validatePasswordAges),
+		),
 		Schema: map[string]*schema.Schema{
 			"source_tenant_id": {
 				Type:         schema.TypeString,
@@ -1232,7 +1242,7 @@ func newTenant() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("tenant", listExportTenants, false),
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -1822,7 +1832,7 @@ func newExternalIdentifierConfiguration() *schema.Resource {
 }
 
 func newEmailConfiguration() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"additional_headers": {
 				Type:        schema.TypeMap,
@@ -2033,6 +2043,10 @@ func newEmailConfiguration() *schema.Resource {
 			},
 		},
 	}
+
+	addWriteOnlySchema(r.Schema, "password", "email_configuration.0.")
+
+	return r
 }
 
 func newLambdaConfiguration() *schema.Resource {
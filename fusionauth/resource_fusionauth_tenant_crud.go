@@ -3,15 +3,15 @@ package fusionauth
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func createTenant(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createTenant(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	tenant, diags := buildTenant(data)
 	if diags != nil {
@@ -28,33 +28,32 @@ func createTenant(_ context.Context, data *schema.ResourceData, i interface{}) d
 		t.SourceTenantId = srcTenant.(string)
 	}
 
-	// Add validation for SCIM configuration fields
-	if err := validateSCIMConfiguration(data); err != nil {
-		return diag.FromErr(err)
-	}
-
 	var tid string
 	if t, ok := data.GetOk("tenant_id"); ok {
 		tid = t.(string)
 	}
-	resp, faErrs, err := client.FAClient.CreateTenant(tid, t)
+	if err := waitForPendingDeletion(ctx, "tenant", tid, retrieveTenantState(client, tid)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, faErrs, err := client.FAClient.CreateTenantWithContext(ctx, tid, t)
 	if err != nil {
 		return diag.Errorf("CreateTenant err: %v", err)
 	}
 
 	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+		return responseErrorDiags(err, newTenant(), "tenant")
 	}
 
 	data.SetId(resp.Tenant.Id)
 	return buildResourceDataFromTenant(resp.Tenant, data)
 }
 
-func readTenant(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readTenant(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.RetrieveTenant(id)
+	resp, faErrs, err := client.FAClient.RetrieveTenantWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -64,13 +63,13 @@ func readTenant(_ context.Context, data *schema.ResourceData, i interface{}) dia
 		return nil
 	}
 	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+		return responseErrorDiags(err, newTenant(), "tenant")
 	}
 
 	return buildResourceDataFromTenant(resp.Tenant, data)
 }
 
-func updateTenant(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateTenant(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	tenant, diags := buildTenant(data)
 	if diags != nil {
@@ -82,30 +81,29 @@ func updateTenant(_ context.Context, data *schema.ResourceData, i interface{}) d
 		WebhookIds: handleStringSlice("webhook_ids", data),
 	}
 
-	// Add validation for SCIM configuration fields
-	if err := validateSCIMConfiguration(data); err != nil {
-		return diag.FromErr(err)
-	}
-
-	resp, faErrs, err := client.FAClient.UpdateTenant(data.Id(), t)
+	resp, faErrs, err := client.FAClient.UpdateTenantWithContext(ctx, data.Id(), t)
 	if err != nil {
 		return diag.Errorf("UpdateTenant err: %v", err)
 	}
 	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+		return responseErrorDiags(err, newTenant(), "tenant")
 	}
 
 	return buildResourceDataFromTenant(resp.Tenant, data)
 }
 
-func deleteTenant(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteTenant(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	resp, faErrs, err := client.FAClient.DeleteTenant(data.Id())
+	resp, faErrs, err := client.FAClient.DeleteTenantWithContext(ctx, data.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
+		return responseErrorDiags(err, newTenant(), "tenant")
+	}
+
+	if err := waitForDeletion(ctx, "tenant", data.Id(), retrieveTenantState(client, data.Id())); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -139,39 +137,70 @@ func resourceTenantUpgradeV0(_ context.Context, rawState map[string]interface{},
 	return rawState, nil
 }
 
-// validateSCIMConfiguration validates that required SCIM fields are provided when SCIM is enabled
-func validateSCIMConfiguration(d *schema.ResourceData) error {
-	// Check if SCIM is enabled
-	scimEnabled := false
-	if scimServerConfig, ok := d.GetOk("scim_server_configuration"); ok && len(scimServerConfig.([]interface{})) > 0 {
-		config := scimServerConfig.([]interface{})[0].(map[string]interface{})
-		if enabled, ok := config["enabled"].(bool); ok && enabled {
-			scimEnabled = true
-		}
+// scimLambdaAttributes are the lambda_configuration attributes FusionAuth
+// requires when SCIM is enabled.
+var scimLambdaAttributes = []string{
+	"scim_enterprise_user_request_converter_id",
+	"scim_enterprise_user_response_converter_id",
+	"scim_group_request_converter_id",
+	"scim_group_response_converter_id",
+	"scim_user_request_converter_id",
+	"scim_user_response_converter_id",
+}
+
+// validateSCIMConfiguration checks that the SCIM lambdas are configured when
+// SCIM is enabled.
+func validateSCIMConfiguration(config cty.Value) error {
+	if !configBool(config, cty.GetAttrPath("scim_server_configuration").IndexInt(0).GetAttr("enabled")) {
+		return nil
 	}
 
-	// If SCIM is enabled, check for required lambda fields
-	if scimEnabled {
-		lambdaConfig, hasLambdaConfig := d.GetOk("lambda_configuration")
-		if !hasLambdaConfig || len(lambdaConfig.([]interface{})) == 0 {
-			return fmt.Errorf("lambda_configuration is required when scim_server_configuration.enabled is true")
-		}
+	lambdaPath := cty.GetAttrPath("lambda_configuration")
+	if !configSet(config, lambdaPath) {
+		return lambdaPath.NewErrorf("lambda_configuration is required when scim_server_configuration.enabled is true")
+	}
 
-		lambdaConfigMap := lambdaConfig.([]interface{})[0].(map[string]interface{})
-		requiredFields := []string{
-			"scim_enterprise_user_request_converter_id",
-			"scim_enterprise_user_response_converter_id",
-			"scim_group_request_converter_id",
-			"scim_group_response_converter_id",
-			"scim_user_request_converter_id",
-			"scim_user_response_converter_id",
+	for _, attribute := range scimLambdaAttributes {
+		path := lambdaPath.IndexInt(0).GetAttr(attribute)
+		if !configSet(config, path) {
+			return path.NewErrorf("%s is required in lambda_configuration when scim_server_configuration.enabled is true", attribute)
 		}
+	}
 
-		for _, field := range requiredFields {
-			if _, ok := lambdaConfigMap[field].(string); !ok || lambdaConfigMap[field].(string) == "" {
-				return fmt.Errorf("%s is required in lambda_configuration when scim_server_configuration.enabled is true", field)
-			}
+	return nil
+}
+
+// validatePasswordAges checks that, when both are enforced, the minimum
+// password age is shorter than the maximum one, so users can still change
+// their password before it expires.
+func validatePasswordAges(config cty.Value) error {
+	minimumPath := cty.GetAttrPath("minimum_password_age").IndexInt(0)
+	maximumPath := cty.GetAttrPath("maximum_password_age").IndexInt(0)
+	if !configBool(config, minimumPath.GetAttr("enabled")) || !configBool(config, maximumPath.GetAttr("enabled")) {
+		return nil
+	}
+
+	// Both default to the schema's default when left unset.
+	seconds, ok := configInt(config, minimumPath.GetAttr("seconds"))
+	if !ok {
+		if configSet(config, minimumPath.GetAttr("seconds")) {
+			return nil
 		}
+		seconds = 30
+	}
+	days, ok := configInt(config, maximumPath.GetAttr("days"))
+	if !ok {
+		if configSet(config, maximumPath.GetAttr("days")) {
+			return nil
+		}
+		days = 180
+	}
+
+	if seconds >= days*24*60*60 {
+		return minimumPath.GetAttr("seconds").NewErrorf(
+			"minimum_password_age.seconds (%d) must be less than maximum_password_age.days (%d) in seconds (%d)",
+			seconds, days, days*24*60*60,
+		)
 	}
 
 	return nil
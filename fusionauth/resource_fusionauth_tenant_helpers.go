@@ -29,7 +29,7 @@ func buildTenant(data *schema.ResourceData) (fusionauth.Tenant, diag.Diagnostics
 			TwoFactorMethodRemoveEmailTemplateId: data.Get("email_configuration.0.two_factor_method_remove_email_template_id").(string),
 			ForgotPasswordEmailTemplateId:        data.Get("email_configuration.0.forgot_password_email_template_id").(string),
 			Host:                                 data.Get("email_configuration.0.host").(string),
-			Password:                             data.Get("email_configuration.0.password").(string),
+			Password:                             sensitiveString(data, "email_configuration.0.password"),
 			PasswordlessEmailTemplateId:          data.Get("email_configuration.0.passwordless_email_template_id").(string),
 			Port:                                 data.Get("email_configuration.0.port").(int),
 			Properties:                           data.Get("email_configuration.0.properties").(string),
@@ -589,43 +589,51 @@ func buildResourceDataFromTenant(t fusionauth.Tenant, data *schema.ResourceData)
 		additionalHeaders[additionalHeader.Name] = additionalHeader.Value
 	}
 
-	err := data.Set("email_configuration", []map[string]interface{}{
-		{
-			"additional_headers": additionalHeaders,
-			"admin_two_factor_method_remove_email_template_id": t.EmailConfiguration.AdminTwoFactorMethodRemoveEmailTemplateId,
-			"debug":                                       t.EmailConfiguration.Debug,
-			"email_update_email_template_id":              t.EmailConfiguration.EmailUpdateEmailTemplateId,
-			"email_verified_email_template_id":            t.EmailConfiguration.EmailVerifiedEmailTemplateId,
-			"forgot_password_email_template_id":           t.EmailConfiguration.ForgotPasswordEmailTemplateId,
-			"host":                                        t.EmailConfiguration.Host,
-			"implicit_email_verification_allowed":         t.EmailConfiguration.ImplicitEmailVerificationAllowed,
-			"login_id_in_use_on_create_email_template_id": t.EmailConfiguration.LoginIdInUseOnCreateEmailTemplateId,
-			"login_id_in_use_on_update_email_template_id": t.EmailConfiguration.LoginIdInUseOnUpdateEmailTemplateId,
-			"login_new_device_email_template_id":          t.EmailConfiguration.LoginNewDeviceEmailTemplateId,
-			"login_suspicious_email_template_id":          t.EmailConfiguration.LoginSuspiciousEmailTemplateId,
-			"password":                                    t.EmailConfiguration.Password,
-			"passwordless_email_template_id":              t.EmailConfiguration.PasswordlessEmailTemplateId,
-			"password_reset_success_email_template_id":    t.EmailConfiguration.PasswordResetSuccessEmailTemplateId,
-			"password_update_email_template_id":           t.EmailConfiguration.PasswordUpdateEmailTemplateId,
-			"port":                                        t.EmailConfiguration.Port,
-			"properties":                                  t.EmailConfiguration.Properties,
-			"security":                                    t.EmailConfiguration.Security,
-			"set_password_email_template_id":              t.EmailConfiguration.SetPasswordEmailTemplateId,
-			"two_factor_method_add_email_template_id":     t.EmailConfiguration.TwoFactorMethodAddEmailTemplateId,
-			"two_factor_method_remove_email_template_id":  t.EmailConfiguration.TwoFactorMethodRemoveEmailTemplateId,
-			"username":                                    t.EmailConfiguration.Username,
-			"verification_email_template_id":              t.EmailConfiguration.VerificationEmailTemplateId,
-			"verification_strategy":                       t.EmailConfiguration.VerificationStrategy,
-			"verify_email":                                t.EmailConfiguration.VerifyEmail,
-			"verify_email_when_changed":                   t.EmailConfiguration.VerifyEmailWhenChanged,
-			"default_from_email":                          t.EmailConfiguration.DefaultFromEmail,
-			"default_from_name":                           t.EmailConfiguration.DefaultFromName,
-			"unverified": []map[string]interface{}{{
-				"allow_email_change_when_gated": t.EmailConfiguration.Unverified.AllowEmailChangeWhenGated,
-				"behavior":                      t.EmailConfiguration.Unverified.Behavior,
-			}},
-		},
-	})
+	emailPassword := t.EmailConfiguration.Password
+	if usesWriteOnly(data, "email_configuration.0.password") {
+		emailPassword = ""
+	}
+
+	emailConfiguration := map[string]interface{}{
+		"additional_headers": additionalHeaders,
+		"admin_two_factor_method_remove_email_template_id": t.EmailConfiguration.AdminTwoFactorMethodRemoveEmailTemplateId,
+		"debug":                                       t.EmailConfiguration.Debug,
+		"email_update_email_template_id":              t.EmailConfiguration.EmailUpdateEmailTemplateId,
+		"email_verified_email_template_id":            t.EmailConfiguration.EmailVerifiedEmailTemplateId,
+		"forgot_password_email_template_id":           t.EmailConfiguration.ForgotPasswordEmailTemplateId,
+		"host":                                        t.EmailConfiguration.Host,
+		"implicit_email_verification_allowed":         t.EmailConfiguration.ImplicitEmailVerificationAllowed,
+		"login_id_in_use_on_create_email_template_id": t.EmailConfiguration.LoginIdInUseOnCreateEmailTemplateId,
+		"login_id_in_use_on_update_email_template_id": t.EmailConfiguration.LoginIdInUseOnUpdateEmailTemplateId,
+		"login_new_device_email_template_id":          t.EmailConfiguration.LoginNewDeviceEmailTemplateId,
+		"login_suspicious_email_template_id":          t.EmailConfiguration.LoginSuspiciousEmailTemplateId,
+		"password":                                    emailPassword,
+		"passwordless_email_template_id":              t.EmailConfiguration.PasswordlessEmailTemplateId,
+		"password_reset_success_email_template_id":    t.EmailConfiguration.PasswordResetSuccessEmailTemplateId,
+		"password_update_email_template_id":           t.EmailConfiguration.PasswordUpdateEmailTemplateId,
+		"port":                                        t.EmailConfiguration.Port,
+		"properties":                                  t.EmailConfiguration.Properties,
+		"security":                                    t.EmailConfiguration.Security,
+		"set_password_email_template_id":              t.EmailConfiguration.SetPasswordEmailTemplateId,
+		"two_factor_method_add_email_template_id":     t.EmailConfiguration.TwoFactorMethodAddEmailTemplateId,
+		"two_factor_method_remove_email_template_id":  t.EmailConfiguration.TwoFactorMethodRemoveEmailTemplateId,
+		"username":                                    t.EmailConfiguration.Username,
+		"verification_email_template_id":              t.EmailConfiguration.VerificationEmailTemplateId,
+		"verification_strategy":                       t.EmailConfiguration.VerificationStrategy,
+		"verify_email":                                t.EmailConfiguration.VerifyEmail,
+		"verify_email_when_changed":                   t.EmailConfiguration.VerifyEmailWhenChanged,
+		"default_from_email":                          t.EmailConfiguration.DefaultFromEmail,
+		"default_from_name":                           t.EmailConfiguration.DefaultFromName,
+		"unverified": []map[string]interface{}{{
+			"allow_email_change_when_gated": t.EmailConfiguration.Unverified.AllowEmailChangeWhenGated,
+			"behavior":                      t.EmailConfiguration.Unverified.Behavior,
+		}},
+	}
+	// A schema without the write-only password, such as the data source's, has no version to keep.
+	if version, ok := data.Get("email_configuration.0.password_wo_version").(int); ok {
+		emailConfiguration["password_wo_version"] = version
+	}
+	err := data.Set("email_configuration", []map[string]interface{}{emailConfiguration})
 	if err != nil {
 		return diag.Errorf("tenant.email_configuration: %s", err.Error())
 	}
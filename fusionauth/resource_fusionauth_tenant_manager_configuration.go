@@ -90,18 +90,18 @@ func resourceTenantManagerConfiguration() *schema.Resource {
 	}
 }
 
-func createTenantManagerConfiguration(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createTenantManagerConfiguration(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	data.SetId("tenantmanager_cfg")
-	if diags := updateTenantManagerCfg(buildTenantManagerConfigurationRequest(data), client); diags != nil {
+	if diags := updateTenantManagerCfg(ctx, buildTenantManagerConfigurationRequest(data), client); diags != nil {
 		return diags
 	}
-	return updateTenantManagerIdpTypeConfigurations(data, client)
+	return updateTenantManagerIdpTypeConfigurations(ctx, data, client)
 }
 
-func readTenantManagerConfiguration(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readTenantManagerConfiguration(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	resp, err := client.FAClient.RetrieveTenantManagerConfiguration()
+	resp, err := client.FAClient.RetrieveTenantManagerConfigurationWithContext(ctx)
 	if err != nil {
 		return diag.Errorf("RetrieveTenantManagerConfiguration err: %v", err)
 	}
@@ -117,25 +117,25 @@ func readTenantManagerConfiguration(_ context.Context, data *schema.ResourceData
 	return buildResourceFromTenantManagerConfiguration(resp.TenantManagerConfiguration, data)
 }
 
-func updateTenantManagerConfiguration(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateTenantManagerConfiguration(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	if diags := updateTenantManagerCfg(buildTenantManagerConfigurationRequest(data), client); diags != nil {
+	if diags := updateTenantManagerCfg(ctx, buildTenantManagerConfigurationRequest(data), client); diags != nil {
 		return diags
 	}
-	return updateTenantManagerIdpTypeConfigurations(data, client)
+	return updateTenantManagerIdpTypeConfigurations(ctx, data, client)
 }
 
-func deleteTenantManagerConfiguration(_ context.Context, _ *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteTenantManagerConfiguration(ctx context.Context, _ *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	if diags := updateTenantManagerCfg(getDefaultTenantManagerConfigurationRequest(), client); diags != nil {
+	if diags := updateTenantManagerCfg(ctx, getDefaultTenantManagerConfigurationRequest(), client); diags != nil {
 		return diags
 	}
 
-	return updateTenantManagerIdpTypeCfgItems(nil, client)
+	return updateTenantManagerIdpTypeCfgItems(ctx, nil, client)
 }
 
-func updateTenantManagerCfg(req fusionauth.TenantManagerConfigurationRequest, client Client) diag.Diagnostics {
-	resp, faErrs, err := client.FAClient.UpdateTenantManagerConfiguration(req)
+func updateTenantManagerCfg(ctx context.Context, req fusionauth.TenantManagerConfigurationRequest, client Client) diag.Diagnostics {
+	resp, faErrs, err := client.FAClient.UpdateTenantManagerConfigurationWithContext(ctx, req)
 	if err != nil {
 		return diag.Errorf("UpdateTenantManagerConfiguration err: %v", err)
 	}
@@ -171,15 +171,15 @@ func buildTenantManagerConfigurationRequest(data *schema.ResourceData) fusionaut
 	return tmc
 }
 
-func updateTenantManagerIdpTypeConfigurations(data *schema.ResourceData, client Client) diag.Diagnostics {
+func updateTenantManagerIdpTypeConfigurations(ctx context.Context, data *schema.ResourceData, client Client) diag.Diagnostics {
 	desiredItems := getTenantManagerIdentityProviderTypeConfigurationItems(data)
-	return updateTenantManagerIdpTypeCfgItems(desiredItems, client)
+	return updateTenantManagerIdpTypeCfgItems(ctx, desiredItems, client)
 }
 
-func updateTenantManagerIdpTypeCfgItems(desiredItems []map[string]interface{}, client Client) diag.Diagnostics {
+func updateTenantManagerIdpTypeCfgItems(ctx context.Context, desiredItems []map[string]interface{}, client Client) diag.Diagnostics {
 	desiredTypes := getTenantManagerIdentityProviderTypes(desiredItems)
 
-	resp, err := client.FAClient.RetrieveTenantManagerConfiguration()
+	resp, err := client.FAClient.RetrieveTenantManagerConfigurationWithContext(ctx)
 	if err != nil {
 		return diag.Errorf("RetrieveTenantManagerConfiguration err: %v", err)
 	}
@@ -188,7 +188,7 @@ func updateTenantManagerIdpTypeCfgItems(desiredItems []map[string]interface{}, c
 	}
 
 	for _, idpType := range getTenantManagerIdentityProviderTypesToDelete(resp.TenantManagerConfiguration.IdentityProviderTypeConfigurations, desiredTypes) {
-		deleteResp, faErrs, deleteErr := client.FAClient.DeleteTenantManagerIdentityProviderTypeConfiguration(idpType)
+		deleteResp, faErrs, deleteErr := client.FAClient.DeleteTenantManagerIdentityProviderTypeConfigurationWithContext(ctx, idpType)
 		if deleteErr != nil {
 			return diag.Errorf("DeleteTenantManagerIdentityProviderTypeConfiguration err: %v", deleteErr)
 		}
@@ -200,14 +200,14 @@ func updateTenantManagerIdpTypeCfgItems(desiredItems []map[string]interface{}, c
 	for _, m := range desiredItems {
 		idpType := m["type"].(string)
 		req := buildTenantManagerIdentityProviderTypeConfigurationRequest(m)
-		updateResp, faErrs, updateErr := client.FAClient.UpdateTenantManagerIdentityProviderTypeConfiguration(
+		updateResp, faErrs, updateErr := client.FAClient.UpdateTenantManagerIdentityProviderTypeConfigurationWithContext(ctx,
 			fusionauth.IdentityProviderType(idpType), req,
 		)
 		if updateErr != nil {
 			return diag.Errorf("UpdateTenantManagerIdentityProviderTypeConfiguration err: %v", updateErr)
 		}
 		if updateResp.StatusCode == http.StatusNotFound {
-			createResp, createErrs, createErr := client.FAClient.CreateTenantManagerIdentityProviderTypeConfiguration(
+			createResp, createErrs, createErr := client.FAClient.CreateTenantManagerIdentityProviderTypeConfigurationWithContext(ctx,
 				fusionauth.IdentityProviderType(idpType), req,
 			)
 			if createErr != nil {
@@ -27,7 +27,7 @@ func TestAccFusionauthTenant_basic(t *testing.T) {
 	startMinimumPasswordAgeSeconds, endMinimumPasswordAgeSeconds := 10, 5
 	startMinimumPasswordAgeEnabled, endMinimumPasswordAgeEnabled := true, false
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t); skipIfFusionAuthBelow(t, "1.68.0") },
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckFusionauthTenantDestroy,
@@ -88,7 +88,7 @@ func TestAccFusionauthTenant_baseURL(t *testing.T) {
 	resourceName := randString10()
 	tfResourcePath := fmt.Sprintf("fusionauth_tenant.test_%s", resourceName)
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t); skipIfFusionAuthBelow(t, "1.68.0") },
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckFusionauthTenantDestroy,
@@ -118,7 +118,7 @@ func TestAccFusionauthTenant_intelligentMFA(t *testing.T) {
 	resourceName := randString10()
 	tfResourcePath := fmt.Sprintf("fusionauth_tenant.test_%s", resourceName)
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t); skipIfFusionAuthBelow(t, "1.68.0") },
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckFusionauthTenantDestroy,
@@ -441,7 +441,7 @@ func newTheme() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("theme", listExportThemes, false),
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -602,7 +602,7 @@ func mergeThemeCustomizations(base fusionauth.Theme, data *schema.ResourceData)
 	return merged, customized
 }
 
-func createTheme(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createTheme(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
 	req := fusionauth.ThemeRequest{
@@ -615,7 +615,7 @@ func createTheme(_ context.Context, data *schema.ResourceData, i interface{}) di
 
 	themeID := data.Get("theme_id").(string)
 
-	resp, faErrs, err := client.FAClient.CreateTheme(themeID, req)
+	resp, faErrs, err := client.FAClient.CreateThemeWithContext(ctx, themeID, req)
 
 	if err != nil {
 		return diag.Errorf("CreateTheme err: %v", err)
@@ -632,7 +632,7 @@ func createTheme(_ context.Context, data *schema.ResourceData, i interface{}) di
 	theme := resp.Theme
 	if req.SourceThemeId != "" {
 		if merged, customized := mergeThemeCustomizations(resp.Theme, data); customized {
-			updateResp, faErrs, err := client.FAClient.UpdateTheme(data.Id(), fusionauth.ThemeRequest{Theme: merged})
+			updateResp, faErrs, err := client.FAClient.UpdateThemeWithContext(ctx, data.Id(), fusionauth.ThemeRequest{Theme: merged})
 			if err != nil {
 				return diag.Errorf("UpdateTheme err: %v", err)
 			}
@@ -646,11 +646,11 @@ func createTheme(_ context.Context, data *schema.ResourceData, i interface{}) di
 	return buildResourceDataFromTheme(theme, data)
 }
 
-func readTheme(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readTheme(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.RetrieveTheme(id)
+	resp, faErrs, err := client.FAClient.RetrieveThemeWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -668,13 +668,13 @@ func readTheme(_ context.Context, data *schema.ResourceData, i interface{}) diag
 	return buildResourceDataFromTheme(t, data)
 }
 
-func updateTheme(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateTheme(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	req := fusionauth.ThemeRequest{
 		Theme: buildTheme(data),
 	}
 
-	resp, faErrs, err := client.FAClient.UpdateTheme(data.Id(), req)
+	resp, faErrs, err := client.FAClient.UpdateThemeWithContext(ctx, data.Id(), req)
 	if err != nil {
 		return diag.Errorf("UpdateTheme err: %v", err)
 	}
@@ -688,11 +688,11 @@ func updateTheme(_ context.Context, data *schema.ResourceData, i interface{}) di
 	return buildResourceDataFromTheme(resp.Theme, data)
 }
 
-func deleteTheme(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteTheme(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteTheme(id)
+	resp, faErrs, err := client.FAClient.DeleteThemeWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
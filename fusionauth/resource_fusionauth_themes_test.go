@@ -22,7 +22,7 @@ func TestAccFusionauthTheme_basic(t *testing.T) {
 	startStyles, endStyles := "/* styles */", "/* changed styles */"
 	startTemplates, endTemplates := generateFusionAuthTemplate(), generateFusionAuthTemplate()
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t); skipIfFusionAuthBelow(t, "1.68.0") },
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckFusionauthThemeDestroy,
@@ -72,7 +72,7 @@ func TestAccFusionauthTheme_sourceThemeID(t *testing.T) {
 	srcTemplates := generateFusionAuthTemplate()
 	customStylesheet := "/* derived custom stylesheet */"
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t); skipIfFusionAuthBelow(t, "1.68.0") },
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckFusionauthThemeDestroy,
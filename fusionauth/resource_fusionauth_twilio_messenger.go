@@ -70,7 +70,7 @@ func newTwilioMessenger() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("messenger", listImportMessengers(fusionauth.MessengerType_Twilio), false),
 		},
 	}
 }
@@ -180,11 +180,11 @@ func updateTwilioMessenger(ctx context.Context, data *schema.ResourceData, i int
 	return nil
 }
 
-func deleteTwilioMessenger(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteTwilioMessenger(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteMessenger(id)
+	resp, faErrs, err := client.FAClient.DeleteMessengerWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -21,7 +21,7 @@ func TestAccTwilioMessenger(t *testing.T) {
 	startName, endName := "my-test-messenger", "my-new-test-messenger"
 	startURL, endURL := "https://api.twilio.com", "https://alt-api.twilio.com"
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckTwilioMessengerDestroy,
 		Steps: []resource.TestStep{
@@ -12,7 +12,7 @@ import (
 )
 
 func newUser() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: createUser,
 		ReadContext:   readUser,
 		UpdateContext: updateUser,
@@ -28,22 +28,22 @@ func newUser() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
+
+	addWriteOnlySchema(r.Schema, "password", "")
+
+	return r
 }
 
-func createUser(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func createUser(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	req, diags := dataToUserRequest(data)
 	if diags != nil {
 		return diags
 	}
 
-	oldTenantID := client.FAClient.TenantId
-	client.FAClient.TenantId = req.User.TenantId
-	defer func() {
-		client.FAClient.TenantId = oldTenantID
-	}()
+	client := i.(Client).withTenantID(req.User.TenantId)
+	req.User.TenantId = client.FAClient.TenantId
 
-	resp, faErrs, err := client.FAClient.CreateUser(req.User.Id, req)
+	resp, faErrs, err := client.FAClient.CreateUserWithContext(ctx, req.User.Id, req)
 	if err != nil {
 		return diag.Errorf("CreateUser err: %v", err)
 	}
@@ -55,11 +55,11 @@ func createUser(_ context.Context, data *schema.ResourceData, i interface{}) dia
 	return userResponseToData(data, resp)
 }
 
-func readUser(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func readUser(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := tenantScopedClient(i.(Client), data)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.RetrieveUser(id)
+	resp, faErrs, err := client.FAClient.RetrieveUserWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -75,14 +75,14 @@ func readUser(_ context.Context, data *schema.ResourceData, i interface{}) diag.
 	return userResponseToData(data, resp)
 }
 
-func updateUser(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func updateUser(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := tenantScopedClient(i.(Client), data)
 	req, diags := dataToUserRequest(data)
 	if diags != nil {
 		return diags
 	}
 
-	resp, faErrs, err := client.FAClient.UpdateUser(data.Id(), req)
+	resp, faErrs, err := client.FAClient.UpdateUserWithContext(ctx, data.Id(), req)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -94,11 +94,11 @@ func updateUser(_ context.Context, data *schema.ResourceData, i interface{}) dia
 	return userResponseToData(data, resp)
 }
 
-func deleteUser(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func deleteUser(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := tenantScopedClient(i.(Client), data)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteUser(id)
+	resp, faErrs, err := client.FAClient.DeleteUserWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -145,6 +145,13 @@ func dataToUserRequest(data *schema.ResourceData) (req fusionauth.UserRequest, d
 		}
 	}
 
+	// The write-only password is only sent when the user is created or its
+	// version changes, as sending it changes the user's password.
+	password := data.Get("password").(string)
+	if password == "" && (data.Id() == "" || data.HasChange("password_wo_version")) {
+		password = sensitiveString(data, "password")
+	}
+
 	req = fusionauth.UserRequest{
 		ApplicationId:      data.Get("application_id").(string),
 		DisableDomainBlock: data.Get("disable_domain_block").(bool),
@@ -168,7 +175,7 @@ func dataToUserRequest(data *schema.ResourceData) (req fusionauth.UserRequest, d
 				Id:                     userID,
 				EncryptionScheme:       data.Get("encryption_scheme").(string),
 				Factor:                 data.Get("factor").(int),
-				Password:               data.Get("password").(string),
+				Password:               password,
 				PasswordChangeRequired: data.Get("password_change_required").(bool),
 				Username:               data.Get("username").(string),
 				UsernameStatus:         fusionauth.ContentStatus(data.Get("username_status").(string)),
@@ -122,7 +122,7 @@ func resourceUserAction() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName("user action", listImportUserActions, false),
 		},
 	}
 }
@@ -182,7 +182,7 @@ func createUserAction(ctx context.Context, data *schema.ResourceData, i interfac
 	client := i.(Client)
 	userAction := buildUserAction(data)
 
-	resp, faErrs, err := client.FAClient.CreateUserAction(userAction.Id, fusionauth.UserActionRequest{
+	resp, faErrs, err := client.FAClient.CreateUserActionWithContext(ctx, userAction.Id, fusionauth.UserActionRequest{
 		UserAction: userAction,
 	})
 
@@ -198,11 +198,11 @@ func createUserAction(ctx context.Context, data *schema.ResourceData, i interfac
 	return readUserAction(ctx, data, i)
 }
 
-func readUserAction(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readUserAction(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, err := client.FAClient.RetrieveUserAction(id)
+	resp, err := client.FAClient.RetrieveUserActionWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -272,7 +272,7 @@ func readUserAction(_ context.Context, data *schema.ResourceData, i interface{})
 func updateUserAction(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	resp, faErrs, err := client.FAClient.UpdateUserAction(data.Id(), fusionauth.UserActionRequest{
+	resp, faErrs, err := client.FAClient.UpdateUserActionWithContext(ctx, data.Id(), fusionauth.UserActionRequest{
 		UserAction: buildUserAction(data),
 	})
 	if err != nil {
@@ -285,10 +285,10 @@ func updateUserAction(ctx context.Context, data *schema.ResourceData, i interfac
 	return readUserAction(ctx, data, i)
 }
 
-func deleteUserAction(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteUserAction(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	resp, faErrs, err := client.FAClient.DeleteUserAction(data.Id())
+	resp, faErrs, err := client.FAClient.DeleteUserActionWithContext(ctx, data.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
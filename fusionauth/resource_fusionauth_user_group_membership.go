@@ -47,7 +47,7 @@ func newUserGroupMembership() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importUserGroupMembership,
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -78,11 +78,11 @@ func buildUserGroupMembership(data *schema.ResourceData) fusionauth.MemberReques
 	return mr
 }
 
-func createUserGroupMembership(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createUserGroupMembership(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	mr := buildUserGroupMembership(data)
 
-	resp, faErrs, err := client.FAClient.CreateGroupMembers(mr)
+	resp, faErrs, err := client.FAClient.CreateGroupMembersWithContext(ctx, mr)
 	if err != nil {
 		return diag.Errorf("CreateUserGroupMembership err: %v", err)
 	}
@@ -95,7 +95,7 @@ func createUserGroupMembership(_ context.Context, data *schema.ResourceData, i i
 	return nil
 }
 
-func readUserGroupMembership(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readUserGroupMembership(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
 	gmsreq := fusionauth.GroupMemberSearchRequest{
@@ -105,7 +105,7 @@ func readUserGroupMembership(_ context.Context, data *schema.ResourceData, i int
 		},
 	}
 
-	resp, faErrs, err := client.FAClient.SearchGroupMembers(gmsreq)
+	resp, faErrs, err := client.FAClient.SearchGroupMembersWithContext(ctx, gmsreq)
 	if err != nil {
 		return diag.Errorf("RetrieveUserGroupMembership err: %v", err)
 	}
@@ -150,11 +150,11 @@ func readUserGroupMembership(_ context.Context, data *schema.ResourceData, i int
 	return nil
 }
 
-func updateUserGroupMembership(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateUserGroupMembership(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	mr := buildUserGroupMembership(data)
 
-	resp, faErrs, err := client.FAClient.UpdateGroupMembers(mr)
+	resp, faErrs, err := client.FAClient.UpdateGroupMembersWithContext(ctx, mr)
 
 	if err != nil {
 		return diag.Errorf("UpdateUserGroupMembership err: %v", err)
@@ -167,13 +167,13 @@ func updateUserGroupMembership(_ context.Context, data *schema.ResourceData, i i
 	return nil
 }
 
-func deleteUserGroupMembership(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteUserGroupMembership(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	mdr := fusionauth.MemberDeleteRequest{
 		MemberIds: []string{data.Id()},
 	}
 
-	resp, faErrs, err := client.FAClient.DeleteGroupMembers(mdr)
+	resp, faErrs, err := client.FAClient.DeleteGroupMembersWithContext(ctx, mdr)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -211,3 +211,20 @@ func resourceUserGroupMembershipUpgradeV0(_ context.Context, rawState map[string
 
 	return rawState, nil
 }
+
+func importUserGroupMembership(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseImportID(d.Id(), "group_id/user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	// The membership is looked up by its group and user, the read replaces
+	// the import ID with the membership's Id.
+	for _, field := range []string{"group_id", "user_id"} {
+		if err := d.Set(field, parts[field]); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
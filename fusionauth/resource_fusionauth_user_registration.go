@@ -1,14 +1,9 @@
 package fusionauth
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
-	"strings"
-	"time"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -95,24 +90,7 @@ func newRegistration() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
-				parts := strings.Split(d.Id(), ":")
-				if len(parts) != 2 {
-					return nil, fmt.Errorf("expected import ID in format user_id:application_id")
-				}
-
-				for index, field := range []string{"user_id", "application_id"} {
-					if _, errs := validation.IsUUID(parts[index], field); len(errs) > 0 {
-						return nil, fmt.Errorf("invalid %s: %s", field, errs[0].Error())
-					}
-
-					if err := d.Set(field, parts[index]); err != nil {
-						return nil, err
-					}
-				}
-
-				return []*schema.ResourceData{d}, nil
-			},
+			StateContext: importRegistration,
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -142,7 +120,7 @@ func buildRegistration(data *schema.ResourceData) fusionauth.RegistrationRequest
 	}
 }
 
-func createRegistration(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createRegistration(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	resourceData, _ := jsonStringToMapStringInterface(data.Get("data").(string))
 	reg := struct {
 		Registration                 fusionauth.UserRegistration `json:"registration,omitempty"`
@@ -163,7 +141,7 @@ func createRegistration(_ context.Context, data *schema.ResourceData, i interfac
 
 	client := i.(Client)
 	b, _ := json.Marshal(reg)
-	b, err := sendCreateRegistration(b, data.Get("user_id").(string), data.Get("application_id").(string), client)
+	b, err := sendCreateRegistration(ctx, b, data.Get("user_id").(string), data.Get("application_id").(string), client)
 	if err != nil {
 		return diag.Errorf("register err: %v", err)
 	}
@@ -175,42 +153,22 @@ func createRegistration(_ context.Context, data *schema.ResourceData, i interfac
 	return buildResourceDataFromRegistration(reg.Registration, data)
 }
 
-func sendCreateRegistration(b []byte, uid string, aid string, client Client) ([]byte, error) {
-	req, err := http.NewRequest(
-		http.MethodPost,
-		fmt.Sprintf("%s/%s/%s/%s", strings.TrimRight(client.Host, "/"), "api/user/registration", uid, aid),
-		bytes.NewBuffer(b),
-	)
-
+func sendCreateRegistration(ctx context.Context, b []byte, uid string, aid string, client Client) ([]byte, error) {
+	resp, faErrs, err := doRawRequest(ctx, client, http.MethodPost, "/api/user/registration/"+uid, aid, b)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Authorization", client.APIKey)
-	req.Header.Add("Content-Type", "application/json")
-
-	hc := http.Client{
-		Timeout: 30 * time.Second,
-	}
-
-	resp, err := hc.Do(req)
-	if err != nil {
+	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	b, _ = io.ReadAll(resp.Body)
 
-	if err := checkResponse(resp.StatusCode, nil); err != nil {
-		return nil, err
-	}
-	return b, nil
+	return resp.Body, nil
 }
 
-func readRegistration(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readRegistration(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	resp, faErrs, err := client.FAClient.RetrieveRegistration(data.Get("user_id").(string), data.Get("application_id").(string))
+	resp, faErrs, err := client.FAClient.RetrieveRegistrationWithContext(ctx, data.Get("user_id").(string), data.Get("application_id").(string))
 	if err != nil {
 		return diag.Errorf("RetrieveRegistration err: %v", err)
 	}
@@ -259,11 +217,11 @@ func buildResourceDataFromRegistration(r fusionauth.UserRegistration, data *sche
 	return nil
 }
 
-func updateRegistration(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateRegistration(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	ur := buildRegistration(data)
 
-	resp, faErrs, err := client.FAClient.UpdateRegistration(data.Get("user_id").(string), ur)
+	resp, faErrs, err := client.FAClient.UpdateRegistrationWithContext(ctx, data.Get("user_id").(string), ur)
 	if err != nil {
 		return diag.Errorf("UpdateRegistration err: %v", err)
 	}
@@ -275,10 +233,10 @@ func updateRegistration(_ context.Context, data *schema.ResourceData, i interfac
 	return nil
 }
 
-func deleteRegistration(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteRegistration(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	resp, faErrs, err := client.FAClient.DeleteRegistration(data.Get("user_id").(string), data.Get("application_id").(string))
+	resp, faErrs, err := client.FAClient.DeleteRegistrationWithContext(ctx, data.Get("user_id").(string), data.Get("application_id").(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -315,3 +273,18 @@ func resourceUserRegistrationUpgradeV0(_ context.Context, rawState map[string]in
 
 	return rawState, nil
 }
+
+func importRegistration(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseImportID(d.Id(), "user_id/application_id", "user_id:application_id")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range []string{"user_id", "application_id"} {
+		if err := d.Set(field, parts[field]); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
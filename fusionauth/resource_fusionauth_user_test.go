@@ -103,7 +103,7 @@ func TestAccFusionauthUser_basic(t *testing.T) {
 	startUsername, endUsername := "john.smith", "jon.snow"
 	startUsernameStatus, endUsernameStatus := "ACTIVE", "PENDING"
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t); skipIfFusionAuthBelow(t, "1.68.0") },
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckFusionauthUserDestroy,
@@ -583,7 +583,7 @@ func TestAccFusionauthUser_twoFactorMethodName(t *testing.T) {
 	tfResourcePath := fmt.Sprintf("fusionauth_user.test_%s", resourceName)
 	startName, endName := "Work phone", "Personal phone"
 
-	resource.Test(t, resource.TestCase{
+	testAccResourceTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t); skipIfFusionAuthBelow(t, "1.68.0") },
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckFusionauthUserDestroy,
@@ -12,7 +12,7 @@ import (
 )
 
 func newWebhook() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: createWebhook,
 		ReadContext:   readWebhook,
 		UpdateContext: updateWebhook,
@@ -419,6 +419,10 @@ func newWebhook() *schema.Resource {
 			},
 		},
 	}
+
+	addWriteOnlySchema(r.Schema, "http_authentication_password", "")
+
+	return r
 }
 
 func buildWebhook(data *schema.ResourceData) fusionauth.Webhook {
@@ -428,7 +432,7 @@ func buildWebhook(data *schema.ResourceData) fusionauth.Webhook {
 		Description:                data.Get("description").(string),
 		EventsEnabled:              buildEventsEnabled("events_enabled", data),
 		Global:                     data.Get("global").(bool),
-		HttpAuthenticationPassword: data.Get("http_authentication_password").(string),
+		HttpAuthenticationPassword: sensitiveString(data, "http_authentication_password"),
 		HttpAuthenticationUsername: data.Get("http_authentication_username").(string),
 		ReadTimeout:                data.Get("read_timeout").(int),
 		SslCertificate:             data.Get("ssl_certificate").(string),
@@ -518,11 +522,11 @@ func buildEventsEnabled(key string, data *schema.ResourceData) map[fusionauth.Ev
 	}
 }
 
-func createWebhook(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createWebhook(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	l := buildWebhook(data)
 	webhookID := data.Get("webhook_id").(string)
-	resp, faErrs, err := client.FAClient.CreateWebhook(webhookID, fusionauth.WebhookRequest{
+	resp, faErrs, err := client.FAClient.CreateWebhookWithContext(ctx, webhookID, fusionauth.WebhookRequest{
 		Webhook: l,
 	})
 	if err != nil {
@@ -536,11 +540,11 @@ func createWebhook(_ context.Context, data *schema.ResourceData, i interface{})
 	return nil
 }
 
-func readWebhook(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readWebhook(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, err := client.FAClient.RetrieveWebhook(id)
+	resp, err := client.FAClient.RetrieveWebhookWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -642,8 +646,10 @@ func readWebhook(_ context.Context, data *schema.ResourceData, i interface{}) di
 	if err := data.Set("headers", l.Headers); err != nil {
 		return diag.Errorf("webhook.headers: %s", err.Error())
 	}
-	if err := data.Set("http_authentication_password", l.HttpAuthenticationPassword); err != nil {
-		return diag.Errorf("webhook.http_authentication_password: %s", err.Error())
+	if !usesWriteOnly(data, "http_authentication_password") {
+		if err := data.Set("http_authentication_password", l.HttpAuthenticationPassword); err != nil {
+			return diag.Errorf("webhook.http_authentication_password: %s", err.Error())
+		}
 	}
 	if err := data.Set("http_authentication_username", l.HttpAuthenticationUsername); err != nil {
 		return diag.Errorf("webhook.http_authentication_username: %s", err.Error())
@@ -663,11 +669,11 @@ func readWebhook(_ context.Context, data *schema.ResourceData, i interface{}) di
 	return nil
 }
 
-func updateWebhook(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateWebhook(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	l := buildWebhook(data)
 
-	resp, faErrs, err := client.FAClient.UpdateWebhook(data.Id(), fusionauth.WebhookRequest{
+	resp, faErrs, err := client.FAClient.UpdateWebhookWithContext(ctx, data.Id(), fusionauth.WebhookRequest{
 		Webhook: l,
 	})
 	if err != nil {
@@ -681,11 +687,11 @@ func updateWebhook(_ context.Context, data *schema.ResourceData, i interface{})
 	return nil
 }
 
-func deleteWebhook(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteWebhook(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteWebhook(id)
+	resp, faErrs, err := client.FAClient.DeleteWebhookWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,89 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// versionedAttribute is an attribute that is only understood by FusionAuth
+// servers at or above MinVersion.
+type versionedAttribute struct {
+	Attribute  string
+	MinVersion string
+}
+
+// requireServerVersion returns a CustomizeDiffFunc that fails the plan when
+// any of the provided attributes is configured while the provider is talking
+// to a FusionAuth server older than the attribute's minimum version. Without
+// this, the server rejects the request at apply time with an opaque 400.
+// Nothing is checked when the server version couldn't be detected.
+func requireServerVersion(attributes ...versionedAttribute) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, i interface{}) error {
+		client, ok := i.(Client)
+		if !ok || client.ServerVersion == "" {
+			return nil
+		}
+
+		config := diff.GetRawConfig()
+		if config.IsNull() || !config.IsKnown() {
+			return nil
+		}
+
+		for _, a := range attributes {
+			if !isConfigured(config, a.Attribute) || !versionOlderThan(client.ServerVersion, a.MinVersion) {
+				continue
+			}
+
+			return fmt.Errorf(
+				"%s requires FusionAuth %s or later, but the server is running %s. Remove %s from the configuration or upgrade FusionAuth",
+				a.Attribute, a.MinVersion, client.ServerVersion, a.Attribute,
+			)
+		}
+
+		return nil
+	}
+}
+
+// isConfigured reports whether the top level attribute is set in the raw
+// configuration. Blocks only count when at least one is present.
+func isConfigured(config cty.Value, attribute string) bool {
+	if !config.Type().IsObjectType() || !config.Type().HasAttribute(attribute) {
+		return false
+	}
+
+	v := config.GetAttr(attribute)
+	if v.IsNull() {
+		return false
+	}
+	if v.IsKnown() && (v.Type().IsListType() || v.Type().IsSetType()) {
+		return v.LengthInt() > 0
+	}
+
+	return true
+}
+
+// versionOlderThan reports whether FusionAuth version a is older than b.
+func versionOlderThan(a, b string) bool {
+	x, y := parseVersion(a), parseVersion(b)
+	for i := 0; i < 3; i++ {
+		if x[i] != y[i] {
+			return x[i] < y[i]
+		}
+	}
+	return false
+}
+
+func parseVersion(v string) [3]int {
+	v = strings.SplitN(v, "-", 2)[0]
+	v = strings.SplitN(v, "+", 2)[0]
+	var out [3]int
+	for i, part := range strings.SplitN(v, ".", 3) {
+		out[i], _ = strconv.Atoi(part)
+	}
+	return out
+}
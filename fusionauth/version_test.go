@@ -4,9 +4,9 @@ import (
 	"encoding/json"
 	"net/http"
 	"os"
-	"strconv"
-	"strings"
 	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
 )
 
 func fusionauthServerVersion(t *testing.T) string {
@@ -41,26 +41,6 @@ func skipIfFusionAuthBelow(t *testing.T, minVersion string) {
 	}
 }
 
-func versionOlderThan(a, b string) bool {
-	x, y := parseVersion(a), parseVersion(b)
-	for i := 0; i < 3; i++ {
-		if x[i] != y[i] {
-			return x[i] < y[i]
-		}
-	}
-	return false
-}
-
-func parseVersion(v string) [3]int {
-	v = strings.SplitN(v, "-", 2)[0]
-	v = strings.SplitN(v, "+", 2)[0]
-	var out [3]int
-	for i, part := range strings.SplitN(v, ".", 3) {
-		out[i], _ = strconv.Atoi(part)
-	}
-	return out
-}
-
 func Test_versionOlderThan(t *testing.T) {
 	cases := []struct {
 		a, b string
@@ -78,3 +58,25 @@ func Test_versionOlderThan(t *testing.T) {
 		}
 	}
 }
+
+func Test_isConfigured(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"name":                    cty.StringVal("app"),
+		"base_url":                cty.NullVal(cty.String),
+		"webauthn_configuration":  cty.ListValEmpty(cty.Object(map[string]cty.Type{"enabled": cty.Bool})),
+		"universal_configuration": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"universal": cty.True})}),
+	})
+
+	cases := map[string]bool{
+		"name":                    true,
+		"base_url":                false,
+		"webauthn_configuration":  false,
+		"universal_configuration": true,
+		"not_in_schema":           false,
+	}
+	for attribute, want := range cases {
+		if got := isConfigured(config, attribute); got != want {
+			t.Errorf("isConfigured(%q) = %v, want %v", attribute, got, want)
+		}
+	}
+}
@@ -4,26 +4,81 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
+	"path/filepath"
 
 	"github.com/FusionAuth/terraform-provider-fusionauth/fusionauth"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 )
 
 func main() {
 	var debugMode bool
+	var exportMode bool
+	var kickstartState string
+	var fromKickstart string
 
 	flag.BoolVar(&debugMode, "debuggable", false, "set to true to run the provider with support for debuggers like delve")
+	flag.BoolVar(&exportMode, "export", false, "set to true to write import and resource blocks for the objects in the FusionAuth instance configured by the FA_DOMAIN and FA_API_KEY environment variables to stdout")
+	flag.StringVar(&kickstartState, "kickstart", "", "path to Terraform state in the format output by `terraform show -json`, or - for stdin, to write a FusionAuth kickstart.json creating the same objects to stdout")
+	flag.StringVar(&fromKickstart, "from-kickstart", "", "path to a FusionAuth kickstart.json, to write resource blocks for the objects it creates to stdout")
 	flag.Parse()
 
-	opts := &plugin.ServeOpts{ProviderFunc: fusionauth.Provider}
+	if exportMode {
+		if err := fusionauth.Export(context.Background(), os.Stdout); err != nil {
+			log.Fatalf("exporting FusionAuth: %s", err)
+		}
+		return
+	}
 
-	if debugMode {
-		err := plugin.Debug(context.Background(), "registry.terraform.io/FusionAuth/fusionauth", opts) //nolint:staticcheck
-		if err != nil {
-			log.Println(err.Error())
+	if kickstartState != "" {
+		if err := writeKickstart(kickstartState); err != nil {
+			log.Fatalf("generating kickstart.json: %s", err)
+		}
+		return
+	}
+
+	if fromKickstart != "" {
+		if err := writeFromKickstart(fromKickstart); err != nil {
+			log.Fatalf("converting kickstart.json: %s", err)
 		}
 		return
 	}
 
-	plugin.Serve(opts)
+	providerServer, err := fusionauth.ProviderServer(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf5server.ServeOpt
+	if debugMode {
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+	}
+
+	if err := tf5server.Serve("registry.terraform.io/FusionAuth/fusionauth", providerServer, serveOpts...); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeKickstart(statePath string) error {
+	if statePath == "-" {
+		return fusionauth.Kickstart(os.Stdin, os.Stdout)
+	}
+
+	f, err := os.Open(statePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return fusionauth.Kickstart(f, os.Stdout)
+}
+
+func writeFromKickstart(kickstartPath string) error {
+	f, err := os.Open(kickstartPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return fusionauth.ImportKickstart(context.Background(), f, filepath.Dir(kickstartPath), os.Stdout)
 }